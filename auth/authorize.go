@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/models"
+)
+
+// Policy allows or denies the request of the principal, denied requests return ErrForbidden
+type Policy func(ctx context.Context, p Principal, request interface{}) error
+
+// TeamsOf returns teams affected by the request, nil stands for free agents
+type TeamsOf func(ctx context.Context, request interface{}) ([]*int, error)
+
+// Authorize runs the policy for the caller put into the context by Middleware, so it must be
+// wrapped by it
+func Authorize(policy Policy) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			p, ok := FromContext(ctx)
+
+			if !ok {
+				return nil, apperrors.ErrUnauthenticated
+			}
+
+			if err := policy(ctx, p, request); err != nil {
+				return nil, err
+			}
+
+			return next(ctx, request)
+		}
+	}
+}
+
+// Allow only given roles
+func RequireRole(roles ...string) endpoint.Middleware {
+	return Authorize(func(_ context.Context, p Principal, _ interface{}) error {
+		for _, role := range roles {
+			if p.Role == role {
+				return nil
+			}
+		}
+
+		return apperrors.ErrForbidden
+	})
+}
+
+// Allow requests which affect only teams managed by the caller. Admins are allowed without looking
+// the teams up.
+func ManageTeams(teams TeamsOf) endpoint.Middleware {
+	return Authorize(func(ctx context.Context, p Principal, request interface{}) error {
+		switch p.Role {
+		case models.RoleAdmin:
+			return nil
+		case models.RoleTeamManager:
+		default:
+			return apperrors.ErrForbidden
+		}
+
+		teamIDs, err := teams(ctx, request)
+
+		if err != nil {
+			return err
+		}
+
+		for _, teamID := range teamIDs {
+			if !p.ManagesTeam(teamID) {
+				return apperrors.ErrForbidden
+			}
+		}
+
+		return nil
+	})
+}
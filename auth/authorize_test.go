@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestManageTeams(t *testing.T) {
+	var lookups int
+
+	e := ManageTeams(func(_ context.Context, request interface{}) ([]*int, error) {
+		lookups++
+
+		return request.([]*int), nil
+	})(func(_ context.Context, request interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	call := func(p Principal, teamIDs ...*int) error {
+		_, err := e(NewContext(context.Background(), p), teamIDs)
+
+		return err
+	}
+
+	admin := Principal{UserID: 1, Role: models.RoleAdmin}
+	manager := Principal{UserID: 2, Role: models.RoleTeamManager, TeamIDs: []int{1, 2}}
+	viewer := Principal{UserID: 3, Role: models.RoleViewer}
+
+	assert.Nil(t, call(admin, intPtr(3), nil))
+	assert.Equal(t, 0, lookups)
+
+	assert.Nil(t, call(manager, intPtr(1)))
+	assert.Nil(t, call(manager, intPtr(1), intPtr(2)))
+	assert.True(t, errors.Is(call(manager, intPtr(1), intPtr(3)), apperrors.ErrForbidden))
+	assert.True(t, errors.Is(call(manager, nil), apperrors.ErrForbidden))
+	assert.True(t, errors.Is(call(viewer, intPtr(1)), apperrors.ErrForbidden))
+	assert.True(t, errors.Is(call(viewer), apperrors.ErrForbidden))
+
+	_, err := e(context.Background(), []*int{intPtr(1)})
+
+	assert.True(t, errors.Is(err, apperrors.ErrUnauthenticated))
+}
+
+func TestRequireRole(t *testing.T) {
+	e := RequireRole(models.RoleAdmin)(func(_ context.Context, request interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	_, err := e(NewContext(context.Background(), Principal{Role: models.RoleAdmin}), nil)
+
+	assert.Nil(t, err)
+
+	_, err = e(NewContext(context.Background(), Principal{Role: models.RoleTeamManager, TeamIDs: []int{1}}), nil)
+
+	assert.True(t, errors.Is(err, apperrors.ErrForbidden))
+}
+
+func TestValidateRole(t *testing.T) {
+	assert.Nil(t, ValidateRole(models.RoleTeamManager, []int{1, 2}))
+	assert.Nil(t, ValidateRole(models.RoleViewer, nil))
+
+	assert.NotNil(t, ValidateRole("owner", nil))
+	assert.NotNil(t, ValidateRole(models.RoleViewer, []int{1}))
+	assert.NotNil(t, ValidateRole(models.RoleTeamManager, []int{1, 1}))
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+	"github.com/logansua/nfl_app/models"
+)
+
+type contextKey int
+
+const principalKey contextKey = iota
+
+// Principal is the authenticated caller of the request
+type Principal struct {
+	UserID int
+	Email  string
+	Role   string
+	// Teams of team managers
+	TeamIDs []int
+}
+
+// Admins manage every team, team managers only their own ones. Free agents (nil team) are managed
+// only by admins.
+func (p Principal) ManagesTeam(teamID *int) bool {
+	if p.Role == models.RoleAdmin {
+		return true
+	}
+
+	if p.Role != models.RoleTeamManager || teamID == nil {
+		return false
+	}
+
+	for _, id := range p.TeamIDs {
+		if id == *teamID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func NewContext(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey, p)
+}
+
+// Principal of the request, it is set only on endpoints wrapped with Middleware
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey).(Principal)
+
+	return p, ok
+}
@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/utils"
+	"github.com/logansua/nfl_app/validation"
+)
+
+type Endpoints struct {
+	LoginEndpoint        endpoint.Endpoint
+	RefreshEndpoint      endpoint.Endpoint
+	CreateAPIKeyEndpoint endpoint.Endpoint
+	GetAPIKeysEndpoint   endpoint.Endpoint
+	DeleteAPIKeyEndpoint endpoint.Endpoint
+}
+
+// Login and refresh are public, API keys are managed by authenticated users
+func MakeServerEndpoints(s Service) Endpoints {
+	validate := validation.Middleware()
+	authenticate := Middleware(s)
+
+	return Endpoints{
+		LoginEndpoint:        validate(MakeLoginEndpoint(s)),
+		RefreshEndpoint:      validate(MakeRefreshEndpoint(s)),
+		CreateAPIKeyEndpoint: authenticate(validate(MakeCreateAPIKeyEndpoint(s))),
+		GetAPIKeysEndpoint:   authenticate(MakeGetAPIKeysEndpoint(s)),
+		DeleteAPIKeyEndpoint: authenticate(MakeDeleteAPIKeyEndpoint(s)),
+	}
+}
+
+func (e Endpoints) Login(ctx context.Context, credentials dto.CredentialsDTO) error {
+	request := loginRequest{Credentials: credentials}
+	response, err := e.LoginEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) Refresh(ctx context.Context, refresh dto.RefreshDTO) error {
+	request := refreshRequest{Refresh: refresh}
+	response, err := e.RefreshEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) CreateAPIKey(ctx context.Context, key dto.APIKeyDTO) error {
+	request := createAPIKeyRequest{Key: key}
+	response, err := e.CreateAPIKeyEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetAPIKeys(ctx context.Context) error {
+	request := getAPIKeysRequest{}
+	response, err := e.GetAPIKeysEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) DeleteAPIKey(ctx context.Context, id int) error {
+	request := apiKeyIdRequest{id: id}
+	_, err := e.DeleteAPIKeyEndpoint(ctx, request)
+
+	return err
+}
+
+func MakeLoginEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(loginRequest)
+
+		var tokens dto.TokensDTO
+
+		err = service.Login(ctx, req.Credentials, &tokens)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: tokens}, nil
+	}
+}
+func MakeRefreshEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(refreshRequest)
+
+		var tokens dto.TokensDTO
+
+		err = service.Refresh(ctx, req.Refresh.RefreshToken, &tokens)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: tokens}, nil
+	}
+}
+func MakeCreateAPIKeyEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(createAPIKeyRequest)
+
+		key := req.Key
+
+		err = service.CreateAPIKey(ctx, &key)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: key}, nil
+	}
+}
+func MakeGetAPIKeysEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		var keys []dto.APIKeyDTO
+
+		err = service.GetAPIKeys(ctx, &keys)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: keys}, nil
+	}
+}
+func MakeDeleteAPIKeyEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(apiKeyIdRequest)
+
+		err = service.DeleteAPIKey(ctx, req.id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+}
+
+type loginRequest struct {
+	Credentials dto.CredentialsDTO
+}
+
+type refreshRequest struct {
+	Refresh dto.RefreshDTO
+}
+
+type createAPIKeyRequest struct {
+	Key dto.APIKeyDTO
+}
+
+type getAPIKeysRequest struct{}
+
+type apiKeyIdRequest struct {
+	id int
+}
@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+// Middleware rejects requests without valid bearer token or API key in the Authorization header and
+// puts the caller into the context. Header is read from the context populated by the transport.
+func Middleware(s Service) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			authorization, _ := ctx.Value(httptransport.ContextKeyRequestAuthorization).(string)
+
+			p, err := s.Authenticate(ctx, authorization)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return next(NewContext(ctx, p), request)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	httptransport "github.com/go-kit/kit/transport/http"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMiddleware(t *testing.T) {
+	s := &service{Secret: []byte("TEST_SECRET")}
+	principal := Principal{UserID: 1, Email: "test@example.com", Role: models.RoleTeamManager, TeamIDs: []int{1, 2}}
+
+	var actual Principal
+
+	e := Middleware(s)(func(ctx context.Context, request interface{}) (interface{}, error) {
+		actual, _ = FromContext(ctx)
+
+		return request, nil
+	})
+
+	call := func(authorization string) error {
+		ctx := context.WithValue(context.Background(), httptransport.ContextKeyRequestAuthorization, authorization)
+
+		_, err := e(ctx, nil)
+
+		return err
+	}
+
+	token, err := issueAccessToken(s.Secret, principal, time.Now())
+
+	assert.Nil(t, err)
+	assert.Nil(t, call("Bearer "+token))
+	assert.Equal(t, principal, actual)
+	assert.Nil(t, call("bearer "+token))
+
+	expired, _ := issueAccessToken(s.Secret, principal, time.Now().Add(-accessTokenTTL-time.Minute))
+	forged, _ := issueAccessToken([]byte("OTHER_SECRET"), principal, time.Now())
+
+	for _, authorization := range []string{"", "Bearer", "Basic dGVzdDp0ZXN0", "Bearer " + expired, "Bearer " + forged, "ApiKey unknown"} {
+		assert.True(t, errors.Is(call(authorization), apperrors.ErrUnauthenticated), authorization)
+	}
+}
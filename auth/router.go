@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/logansua/nfl_app/router"
+	"github.com/logansua/nfl_app/validation"
+	"net/http"
+	"strconv"
+)
+
+func GetServiceOptions(logger log.Logger) []httptransport.ServerOption {
+	return router.ServerOptions(logger)
+}
+
+func CreateRoutes(s Service, logger log.Logger) []router.Route {
+	endpoints := MakeServerEndpoints(s)
+
+	options := GetServiceOptions(logger)
+
+	return []router.Route{
+		{
+			Name:        "Login",
+			Method:      http.MethodPost,
+			Path:        "/auth/login",
+			StrictSlash: false,
+			Handler: httptransport.NewServer(
+				endpoints.LoginEndpoint,
+				decodeLoginRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Refresh tokens",
+			Method:      http.MethodPost,
+			Path:        "/auth/refresh",
+			StrictSlash: false,
+			Handler: httptransport.NewServer(
+				endpoints.RefreshEndpoint,
+				decodeRefreshRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Create API key",
+			Method:      http.MethodPost,
+			Path:        "/auth/api-keys",
+			StrictSlash: false,
+			Handler: httptransport.NewServer(
+				endpoints.CreateAPIKeyEndpoint,
+				decodeCreateAPIKeyRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get API keys",
+			Method:      http.MethodGet,
+			Path:        "/auth/api-keys",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetAPIKeysEndpoint,
+				decodeGetAPIKeysRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Delete API key",
+			Method:      http.MethodDelete,
+			Path:        "/auth/api-keys/{id}",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.DeleteAPIKeyEndpoint,
+				decodeDeleteAPIKeyRequest,
+				router.EncodeNoContentResponse,
+				options...,
+			),
+		},
+	}
+}
+
+func decodeLoginRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req loginRequest
+
+	if e := validation.DecodeJSON(r.Body, &req.Credentials); e != nil {
+		return nil, e
+	}
+
+	return req, nil
+}
+func decodeRefreshRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req refreshRequest
+
+	if e := validation.DecodeJSON(r.Body, &req.Refresh); e != nil {
+		return nil, e
+	}
+
+	return req, nil
+}
+func decodeCreateAPIKeyRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req createAPIKeyRequest
+
+	if e := validation.DecodeJSON(r.Body, &req.Key); e != nil {
+		return nil, e
+	}
+
+	return req, nil
+}
+func decodeGetAPIKeysRequest(_ context.Context, _ *http.Request) (request interface{}, err error) {
+	return getAPIKeysRequest{}, nil
+}
+func decodeDeleteAPIKeyRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req apiKeyIdRequest
+
+	params := mux.Vars(r)
+
+	id, err := strconv.Atoi(params["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.id = id
+
+	return req, nil
+}
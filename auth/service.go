@@ -0,0 +1,300 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"github.com/logansua/nfl_app/db"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"golang.org/x/crypto/bcrypt"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	bearerScheme = "Bearer"
+	apiKeyScheme = "ApiKey"
+)
+
+var errInvalidCredentials = apperrors.New(apperrors.KindUnauthenticated, "invalid email or password")
+
+// Compared with the password of unknown users so the response time doesn't reveal which emails exist
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("dummy password"), bcrypt.DefaultCost)
+
+// Service authenticates users with passwords and issues tokens and API keys.
+type Service interface {
+	// Create user with hashed password, team managers are given their teams
+	CreateUser(ctx context.Context, credentials dto.CredentialsDTO, role string, teamIDs []int) error
+	// Change role and teams of the user
+	UpdateUserRole(ctx context.Context, email, role string, teamIDs []int) error
+	// Exchange email and password for access and refresh tokens
+	Login(ctx context.Context, credentials dto.CredentialsDTO, tokens *dto.TokensDTO) error
+	// Exchange refresh token for a new pair of tokens, each refresh token can be used once
+	Refresh(ctx context.Context, refreshToken string, tokens *dto.TokensDTO) error
+	// Create API key of the caller, the key is returned only once
+	CreateAPIKey(ctx context.Context, key *dto.APIKeyDTO) error
+	// Get API keys of the caller
+	GetAPIKeys(ctx context.Context, keys *[]dto.APIKeyDTO) error
+	// Delete API key of the caller
+	DeleteAPIKey(ctx context.Context, id int) error
+	// Identify the caller by value of the Authorization header
+	Authenticate(ctx context.Context, authorization string) (Principal, error)
+	// Check the API key in value of the Authorization header without recording its use
+	VerifyAPIKey(ctx context.Context, authorization string) error
+}
+
+type service struct {
+	DB     *db.DB
+	Secret []byte
+}
+
+// Tokens are signed with JWT_SECRET which must be set
+func New(dbService *db.DB) (Service, error) {
+	secret := os.Getenv("JWT_SECRET")
+
+	if secret == "" {
+		return nil, errors.New("JWT_SECRET is not set")
+	}
+
+	return &service{DB: dbService, Secret: []byte(secret)}, nil
+}
+
+func (s *service) CreateUser(ctx context.Context, credentials dto.CredentialsDTO, role string, teamIDs []int) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(credentials.Password), bcrypt.DefaultCost)
+
+	if err != nil {
+		return err
+	}
+
+	return s.DB.UserRepository.CreateUser(&models.User{
+		Email:        strings.TrimSpace(credentials.Email),
+		PasswordHash: string(hash),
+		Role:         role,
+	}, teamIDs)
+}
+
+func (s *service) UpdateUserRole(ctx context.Context, email, role string, teamIDs []int) error {
+	var u models.User
+
+	return s.DB.UserRepository.UpdateUserRole(strings.TrimSpace(email), role, teamIDs, &u)
+}
+
+func (s *service) Login(ctx context.Context, credentials dto.CredentialsDTO, tokens *dto.TokensDTO) error {
+	var u models.User
+
+	err := s.DB.UserRepository.FindUserByEmail(strings.TrimSpace(credentials.Email), &u)
+
+	if errors.Is(err, apperrors.ErrNotFound) {
+		bcrypt.CompareHashAndPassword(dummyHash, []byte(credentials.Password))
+
+		return errInvalidCredentials
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(credentials.Password)) != nil {
+		return errInvalidCredentials
+	}
+
+	refreshToken, err := newSecret()
+
+	if err != nil {
+		return err
+	}
+
+	err = s.DB.Repository.Create(&models.RefreshToken{
+		UserID:    int(u.ID),
+		TokenHash: hashSecret(refreshToken),
+		ExpiresAt: time.Now().Add(refreshTokenTTL),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return s.issueTokens(u, refreshToken, tokens)
+}
+
+func (s *service) Refresh(ctx context.Context, refreshToken string, tokens *dto.TokensDTO) error {
+	next, err := newSecret()
+
+	if err != nil {
+		return err
+	}
+
+	t := models.RefreshToken{
+		TokenHash: hashSecret(next),
+		ExpiresAt: time.Now().Add(refreshTokenTTL),
+	}
+
+	err = s.DB.UserRepository.RotateRefreshToken(hashSecret(refreshToken), &t)
+
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return errInvalidToken
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return s.issueTokens(t.User, next, tokens)
+}
+
+func (s *service) issueTokens(u models.User, refreshToken string, tokens *dto.TokensDTO) error {
+	accessToken, err := issueAccessToken(s.Secret, newPrincipal(u), time.Now())
+
+	if err != nil {
+		return err
+	}
+
+	*tokens = dto.TokensDTO{
+		AccessToken:  accessToken,
+		TokenType:    bearerScheme,
+		ExpiresIn:    int(accessTokenTTL.Seconds()),
+		RefreshToken: refreshToken,
+	}
+
+	return nil
+}
+
+func (s *service) CreateAPIKey(ctx context.Context, key *dto.APIKeyDTO) error {
+	p, ok := FromContext(ctx)
+
+	if !ok {
+		return apperrors.ErrUnauthenticated
+	}
+
+	secret, err := newSecret()
+
+	if err != nil {
+		return err
+	}
+
+	value := apiKeyPrefix + secret
+
+	k := models.APIKey{
+		UserID:  p.UserID,
+		Name:    key.Name,
+		Prefix:  value[:apiKeyPrefixLength],
+		KeyHash: hashSecret(value),
+	}
+
+	err = s.DB.Repository.Create(&k)
+
+	if err != nil {
+		return err
+	}
+
+	*key = models.NewAPIKeyDTO(k)
+	key.Key = value
+
+	return nil
+}
+
+func (s *service) GetAPIKeys(ctx context.Context, keys *[]dto.APIKeyDTO) error {
+	p, ok := FromContext(ctx)
+
+	if !ok {
+		return apperrors.ErrUnauthenticated
+	}
+
+	var k []models.APIKey
+
+	err := s.DB.UserRepository.FindUserAPIKeys(p.UserID, &k)
+
+	*keys = make([]dto.APIKeyDTO, len(k))
+
+	for key, value := range k {
+		(*keys)[key] = models.NewAPIKeyDTO(value)
+	}
+
+	return err
+}
+
+func (s *service) DeleteAPIKey(ctx context.Context, id int) error {
+	p, ok := FromContext(ctx)
+
+	if !ok {
+		return apperrors.ErrUnauthenticated
+	}
+
+	return s.DB.UserRepository.DeleteAPIKey(p.UserID, id)
+}
+
+func (s *service) Authenticate(ctx context.Context, authorization string) (Principal, error) {
+	scheme, credentials := splitAuthorization(authorization)
+
+	switch {
+	case scheme == bearerScheme && credentials != "":
+		return parseAccessToken(s.Secret, credentials)
+	case scheme == apiKeyScheme:
+		var k models.APIKey
+
+		err := s.findAPIKey(credentials, &k)
+
+		if err != nil {
+			return Principal{}, err
+		}
+
+		err = s.DB.UserRepository.TouchAPIKey(&k)
+
+		if err != nil {
+			return Principal{}, err
+		}
+
+		return newPrincipal(k.User), nil
+	default:
+		return Principal{}, apperrors.ErrUnauthenticated
+	}
+}
+
+func (s *service) VerifyAPIKey(ctx context.Context, authorization string) error {
+	scheme, credentials := splitAuthorization(authorization)
+
+	if scheme != apiKeyScheme {
+		return apperrors.ErrUnauthenticated
+	}
+
+	var k models.APIKey
+
+	return s.findAPIKey(credentials, &k)
+}
+
+func (s *service) findAPIKey(credentials string, key *models.APIKey) error {
+	if !strings.HasPrefix(credentials, apiKeyPrefix) {
+		return apperrors.ErrUnauthenticated
+	}
+
+	err := s.DB.UserRepository.FindAPIKey(hashSecret(credentials), key)
+
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return errInvalidToken
+	}
+
+	return err
+}
+
+// Scheme of the header is matched case-insensitively
+func splitAuthorization(authorization string) (scheme, credentials string) {
+	parts := strings.SplitN(strings.TrimSpace(authorization), " ", 2)
+
+	if len(parts) != 2 {
+		return "", ""
+	}
+
+	for _, known := range []string{bearerScheme, apiKeyScheme} {
+		if strings.EqualFold(parts[0], known) {
+			return known, strings.TrimSpace(parts[1])
+		}
+	}
+
+	return parts[0], strings.TrimSpace(parts[1])
+}
+
+func newPrincipal(u models.User) Principal {
+	return Principal{UserID: int(u.ID), Email: u.Email, Role: u.Role, TeamIDs: u.TeamIDs()}
+}
@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/golang-jwt/jwt"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"strconv"
+	"time"
+)
+
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 30 * 24 * time.Hour
+	// Length of random part of refresh tokens and API keys in bytes
+	secretLength = 32
+	apiKeyPrefix = "nfl_"
+	// Characters of the key kept in plain text to recognize it
+	apiKeyPrefixLength = 12
+)
+
+var errInvalidToken = apperrors.New(apperrors.KindUnauthenticated, "invalid or expired token")
+
+type accessClaims struct {
+	jwt.StandardClaims
+	Email   string `json:"email"`
+	Role    string `json:"role"`
+	TeamIDs []int  `json:"team_ids,omitempty"`
+}
+
+// Signed JWT identifying the user with the role, it can't be revoked and so it is short-lived. Changes of
+// the role take effect when the token is refreshed.
+func issueAccessToken(secret []byte, p Principal, now time.Time) (string, error) {
+	claims := accessClaims{
+		StandardClaims: jwt.StandardClaims{
+			Subject:   strconv.Itoa(p.UserID),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(accessTokenTTL).Unix(),
+		},
+		Email:   p.Email,
+		Role:    p.Role,
+		TeamIDs: p.TeamIDs,
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+}
+
+func parseAccessToken(secret []byte, token string) (Principal, error) {
+	var claims accessClaims
+
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+
+		return secret, nil
+	})
+
+	if err != nil {
+		return Principal{}, errInvalidToken
+	}
+
+	userID, err := strconv.Atoi(claims.Subject)
+
+	if err != nil {
+		return Principal{}, errInvalidToken
+	}
+
+	return Principal{UserID: userID, Email: claims.Email, Role: claims.Role, TeamIDs: claims.TeamIDs}, nil
+}
+
+// Random secret encoded as hex
+func newSecret() (string, error) {
+	b := make([]byte, secretLength)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// Refresh tokens and API keys are stored only as hashes, they are random so no salt is needed
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+	"strings"
+)
+
+const (
+	maxLength = 255
+	// bcrypt rejects passwords longer than 72 bytes
+	maxPasswordLength = 72
+	minPasswordLength = 8
+)
+
+// Used by login and user creation, login doesn't check password length of existing users
+func validateCredentials(credentials dto.CredentialsDTO) *validation.Builder {
+	return validation.New().
+		Required("email", credentials.Email).
+		MaxLength("email", credentials.Email, maxLength).
+		Check(credentials.Email == "" || strings.Contains(credentials.Email, "@"), "email", validation.CodeInvalid).
+		Required("password", credentials.Password)
+}
+
+func ValidateUser(credentials dto.CredentialsDTO) error {
+	return validateCredentials(credentials).
+		Check(credentials.Password == "" || len(credentials.Password) >= minPasswordLength, "password", validation.CodeMin).
+		Check(len(credentials.Password) <= maxPasswordLength, "password", validation.CodeTooLong).
+		Err()
+}
+
+// Only team managers have teams, each listed once
+func ValidateRole(role string, teamIDs []int) error {
+	errs := validation.New().
+		OneOf("role", role, models.Roles...).
+		Check(role == models.RoleTeamManager || len(teamIDs) == 0, "team_ids", validation.CodeInvalid)
+
+	seen := make(map[int]bool, len(teamIDs))
+
+	for i, id := range teamIDs {
+		field := fmt.Sprintf("team_ids[%d]", i)
+
+		errs.ID(field, id).
+			Check(!seen[id], field, validation.CodeDuplicate)
+
+		seen[id] = true
+	}
+
+	return errs.Err()
+}
+
+func (r loginRequest) Validate(_ context.Context) error {
+	return validateCredentials(r.Credentials).Err()
+}
+
+func (r refreshRequest) Validate(_ context.Context) error {
+	return validation.New().
+		Required("refresh_token", r.Refresh.RefreshToken).
+		Err()
+}
+
+func (r createAPIKeyRequest) Validate(_ context.Context) error {
+	return validation.New().
+		Required("name", r.Key.Name).
+		MaxLength("name", r.Key.Name, maxLength).
+		Err()
+}
@@ -0,0 +1,20 @@
+package bucket
+
+import (
+	"context"
+	"io"
+)
+
+// Driver is a storage backend used by bucket service to keep uploaded files.
+type Driver interface {
+	// Store file content under given path and return public URL of the file
+	Put(ctx context.Context, path string, content io.Reader, contentType string) (string, error)
+	// Release resources held by driver
+	Close() error
+}
+
+const (
+	DriverGCS    = "gcs"
+	DriverLocal  = "local"
+	DriverMemory = "memory"
+)
@@ -0,0 +1,20 @@
+package bucket
+
+import (
+	"context"
+	"errors"
+	apperrors "github.com/logansua/nfl_app/errors"
+)
+
+// Translate storage driver errors into application errors
+func translate(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return apperrors.Wrap(apperrors.KindUnavailable, "file storage is unavailable", err)
+	}
+
+	return apperrors.Wrap(apperrors.KindInternal, "failed to store file", err)
+}
@@ -0,0 +1,61 @@
+package bucket
+
+import (
+	"cloud.google.com/go/storage"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+type gcsDriver struct {
+	Client     *storage.Client
+	Bucket     *storage.BucketHandle
+	BucketName string
+}
+
+// Google Cloud Storage driver, configured by GOOGLE_CLOUD_BUCKET_NAME
+func NewGCSDriver(ctx context.Context) (Driver, error) {
+	bucketName := os.Getenv("GOOGLE_CLOUD_BUCKET_NAME")
+
+	if bucketName == "" {
+		return nil, errors.New("GOOGLE_CLOUD_BUCKET_NAME is not set")
+	}
+
+	client, err := storage.NewClient(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &gcsDriver{
+		Client:     client,
+		Bucket:     client.Bucket(bucketName),
+		BucketName: bucketName,
+	}, nil
+}
+
+func (d *gcsDriver) Put(ctx context.Context, path string, content io.Reader, contentType string) (string, error) {
+	writer := d.Bucket.Object(path).NewWriter(ctx)
+
+	// Warning: storage.AllUsers gives public read access to anyone.
+	writer.ACL = []storage.ACLRule{{Entity: storage.AllUsers, Role: storage.RoleReader}}
+	writer.ContentType = contentType
+
+	// Entries are immutable, be aggressive about caching (1 day).
+	writer.CacheControl = "public, max-age=86400"
+
+	if _, err := io.Copy(writer, content); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("https://storage.googleapis.com/%s/%s", d.BucketName, path), nil
+}
+
+func (d *gcsDriver) Close() error {
+	return d.Client.Close()
+}
@@ -0,0 +1,51 @@
+package bucket
+
+import (
+	"github.com/logansua/nfl_app/validation"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+// Image types accepted for upload with the extension of stored files
+var imageExtensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/webp": ".webp",
+}
+
+// Detect type of uploaded image from its content, the client supplied Content-Type and file name
+// aren't trusted
+func detectImage(file multipart.File) (contentType, ext string, err error) {
+	head := make([]byte, 512)
+
+	n, err := io.ReadFull(file, head)
+
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", "", err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", "", err
+	}
+
+	contentType = http.DetectContentType(head[:n])
+	ext, ok := imageExtensions[contentType]
+
+	if !ok {
+		return "", "", validation.Errors{{Field: "image", Code: validation.CodeInvalid}}
+	}
+
+	return contentType, ext, nil
+}
+
+// Content type of stored image by file extension
+func imageContentType(ext string) (string, bool) {
+	for contentType, e := range imageExtensions {
+		if e == ext {
+			return contentType, true
+		}
+	}
+
+	return "", false
+}
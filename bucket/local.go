@@ -0,0 +1,108 @@
+package bucket
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const LocalURLPrefix = "/uploads/"
+
+type localDriver struct {
+	Root string
+}
+
+// Local filesystem driver, files are stored under root directory and served by router
+func NewLocalDriver(root string) (Driver, error) {
+	if root == "" {
+		root = "./uploads"
+	}
+
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, err
+	}
+
+	return &localDriver{Root: root}, nil
+}
+
+func (d *localDriver) Put(ctx context.Context, path string, content io.Reader, contentType string) (string, error) {
+	fullPath := filepath.Join(d.Root, filepath.FromSlash(path))
+
+	if !strings.HasPrefix(fullPath, filepath.Clean(d.Root)+string(filepath.Separator)) {
+		return "", os.ErrPermission
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return "", err
+	}
+
+	file, err := os.Create(fullPath)
+
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(file, content); err != nil {
+		file.Close()
+
+		return "", err
+	}
+	if err := file.Close(); err != nil {
+		return "", err
+	}
+
+	return LocalURLPrefix + path, nil
+}
+
+func (d *localDriver) Close() error {
+	return nil
+}
+
+// Serve stored images only, directories aren't listed and other files are downloaded as attachments
+func (d *localDriver) Handler() http.Handler {
+	files := http.StripPrefix(LocalURLPrefix, http.FileServer(fileSystem{http.Dir(d.Root)}))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+
+		if contentType, ok := imageContentType(path.Ext(r.URL.Path)); ok {
+			w.Header().Set("Content-Type", contentType)
+		} else {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Content-Disposition", "attachment")
+		}
+
+		files.ServeHTTP(w, r)
+	})
+}
+
+// File system which hides directories from the file server
+type fileSystem struct {
+	http.FileSystem
+}
+
+func (fs fileSystem) Open(name string) (http.File, error) {
+	file, err := fs.FileSystem.Open(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+
+	if err == nil && info.IsDir() {
+		err = os.ErrNotExist
+	}
+
+	if err != nil {
+		file.Close()
+
+		return nil, err
+	}
+
+	return file, nil
+}
@@ -0,0 +1,55 @@
+package bucket
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestLocalDriver_Handler(t *testing.T) {
+	root, err := ioutil.TempDir("", "uploads")
+
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(root)
+
+	driver, err := NewLocalDriver(root)
+
+	assert.Nil(t, err)
+
+	_, err = driver.Put(context.Background(), "players/1/avatars/a.png", strings.NewReader("TEST_AVATAR"), "image/png")
+	assert.Nil(t, err)
+	_, err = driver.Put(context.Background(), "players/1/avatars/a.html", strings.NewReader("<script>"), "text/html")
+	assert.Nil(t, err)
+
+	handler := driver.(handlerDriver).Handler()
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		return w
+	}
+
+	w := serve("/uploads/players/1/avatars/a.png")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+	assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+
+	w = serve("/uploads/players/1/avatars/a.html")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "attachment", w.Header().Get("Content-Disposition"))
+	assert.NotContains(t, w.Header().Get("Content-Type"), "html")
+
+	w = serve("/uploads/players/1/avatars/")
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,47 @@
+package bucket
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"sync"
+)
+
+// MemoryDriver keeps files in memory, it is meant to be used in tests
+type MemoryDriver struct {
+	mu    sync.RWMutex
+	files map[string][]byte
+}
+
+func NewMemoryDriver() *MemoryDriver {
+	return &MemoryDriver{files: map[string][]byte{}}
+}
+
+func (d *MemoryDriver) Put(ctx context.Context, path string, content io.Reader, contentType string) (string, error) {
+	data, err := ioutil.ReadAll(content)
+
+	if err != nil {
+		return "", err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.files[path] = data
+
+	return "memory://" + path, nil
+}
+
+// Get content of stored file by path
+func (d *MemoryDriver) Get(path string) ([]byte, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	data, ok := d.files[path]
+
+	return data, ok
+}
+
+func (d *MemoryDriver) Close() error {
+	return nil
+}
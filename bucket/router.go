@@ -0,0 +1,36 @@
+package bucket
+
+import (
+	"github.com/logansua/nfl_app/router"
+	"net/http"
+)
+
+type handlerDriver interface {
+	Handler() http.Handler
+}
+
+// Routes serving stored files back, only drivers without public URLs provide them
+func CreateRoutes(s Service) []router.Route {
+	svc, ok := s.(*service)
+
+	if !ok {
+		return nil
+	}
+
+	driver, ok := svc.Driver.(handlerDriver)
+
+	if !ok {
+		return nil
+	}
+
+	return []router.Route{
+		{
+			Name:        "Get uploaded file",
+			Method:      http.MethodGet,
+			Path:        LocalURLPrefix,
+			PathPrefix:  true,
+			StrictSlash: false,
+			Handler:     driver.Handler(),
+		},
+	}
+}
@@ -1,15 +1,11 @@
 package bucket
 
 import (
-	"cloud.google.com/go/storage"
 	"context"
-	"errors"
 	"fmt"
 	"github.com/logansua/nfl_app/utils"
-	"io"
 	"mime/multipart"
 	"os"
-	"path"
 )
 
 type UploadFileToBucketRequest struct {
@@ -22,96 +18,84 @@ type UploadFileToBucketResponse struct {
 	Url string `json:"url"`
 }
 
+// Service stores uploaded images and returns their public URLs
 type Service interface {
 	UploadPlayerAvatar(ctx context.Context, id uint, file multipart.File, fileHeader *multipart.FileHeader) (string, error)
 	UploadTeamLogo(ctx context.Context, id uint, file multipart.File, fileHeader *multipart.FileHeader) (string, error)
+	// Release the storage client
+	Close() error
 }
 
 type service struct {
-	Bucket *storage.BucketHandle
+	Driver Driver
 }
 
-var (
-	StorageBucketName string
-	StorageBucket     *storage.BucketHandle
-)
-
+// Initialize storage driver selected by STORAGE_DRIVER
 func New() (Service, error) {
-	var err error
-
-	StorageBucketName = os.Getenv("GOOGLE_CLOUD_BUCKET_NAME")
-
-	if StorageBucketName == "" {
-		return nil, errors.New("GOOGLE_CLOUD_BUCKET_NAME is not set")
-	}
-
-	StorageBucket, err = configureStorage(StorageBucketName)
+	driver, err := newDriver(os.Getenv("STORAGE_DRIVER"))
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &service{Bucket: StorageBucket}, nil
+	return NewWithDriver(driver), nil
 }
 
-func (s *service) UploadPlayerAvatar(ctx context.Context, id uint, file multipart.File, fileHeader *multipart.FileHeader) (string, error) {
-	name := fmt.Sprintf("%s%s", utils.RandToken(), path.Ext(fileHeader.Filename))
-	filePath := fmt.Sprintf("%s/%d/%s/%s", "players", id, "avatars", name)
-
-	_, err := uploadFileToBucket(ctx, file, fileHeader, filePath)
+func NewWithDriver(driver Driver) Service {
+	return &service{Driver: driver}
+}
 
-	if err != nil {
-		return "", err
+func newDriver(name string) (Driver, error) {
+	switch name {
+	case DriverGCS, "":
+		return NewGCSDriver(context.Background())
+	case DriverLocal:
+		return NewLocalDriver(os.Getenv("APP_UPLOADS_PATH"))
+	case DriverMemory:
+		return NewMemoryDriver(), nil
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", name)
 	}
-
-	return name, err
 }
 
-func (s *service) UploadTeamLogo(ctx context.Context, id uint, file multipart.File, fileHeader *multipart.FileHeader) (string, error) {
-	name := fmt.Sprintf("%s%s", utils.RandToken(), path.Ext(fileHeader.Filename))
-	filePath := fmt.Sprintf("%s/%d/%s/%s", "teams", id, "logos", name)
-
-	_, err := uploadFileToBucket(ctx, file, fileHeader, filePath)
+func (s *service) UploadPlayerAvatar(ctx context.Context, id uint, file multipart.File, fileHeader *multipart.FileHeader) (string, error) {
+	contentType, ext, err := detectImage(file)
 
 	if err != nil {
 		return "", err
 	}
 
-	return name, err
-}
+	name := fmt.Sprintf("%s%s", utils.RandToken(), ext)
+	filePath := fmt.Sprintf("%s/%d/%s/%s", "players", id, "avatars", name)
 
-func uploadFileToBucket(ctx context.Context, file multipart.File, fileHeader *multipart.FileHeader, fullPath string) (url string, err error) {
-	if StorageBucket == nil {
-		return "", errors.New("storage bucket is missing")
-	}
+	url, err := s.Driver.Put(ctx, filePath, file, contentType)
 
-	writer := StorageBucket.Object(fullPath).NewWriter(ctx)
+	if err != nil {
+		return "", translate(err)
+	}
 
-	// Warning: storage.AllUsers gives public read access to anyone.
-	writer.ACL = []storage.ACLRule{{Entity: storage.AllUsers, Role: storage.RoleReader}}
-	writer.ContentType = fileHeader.Header.Get("Content-Type")
+	return url, nil
+}
 
-	// Entries are immutable, be aggressive about caching (1 day).
-	writer.CacheControl = "public, max-age=86400"
+func (s *service) UploadTeamLogo(ctx context.Context, id uint, file multipart.File, fileHeader *multipart.FileHeader) (string, error) {
+	contentType, ext, err := detectImage(file)
 
-	if _, err := io.Copy(writer, file); err != nil {
-		return "", err
-	}
-	if err := writer.Close(); err != nil {
+	if err != nil {
 		return "", err
 	}
 
-	return fmt.Sprintf("https://storage.googleapis.com/%s/%s", StorageBucketName, fullPath), nil
-}
-
-func configureStorage(bucketID string) (*storage.BucketHandle, error) {
-	ctx := context.Background()
+	name := fmt.Sprintf("%s%s", utils.RandToken(), ext)
+	filePath := fmt.Sprintf("%s/%d/%s/%s", "teams", id, "logos", name)
 
-	client, err := storage.NewClient(ctx)
+	url, err := s.Driver.Put(ctx, filePath, file, contentType)
 
 	if err != nil {
-		return nil, err
+		return "", translate(err)
 	}
 
-	return client.Bucket(bucketID), nil
+	return url, nil
+}
+
+func (s *service) Close() error {
+	return s.Driver.Close()
 }
@@ -0,0 +1,77 @@
+package bucket
+
+import (
+	"bytes"
+	"context"
+	"github.com/logansua/nfl_app/validation"
+	"github.com/stretchr/testify/assert"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+type testFile struct {
+	*bytes.Reader
+}
+
+func (f testFile) Close() error {
+	return nil
+}
+
+func TestService_UploadPlayerAvatar(t *testing.T) {
+	content := append([]byte("\x89PNG\x0D\x0A\x1A\x0A"), "TEST_AVATAR"...)
+	fileHeader := &multipart.FileHeader{
+		Filename: "avatar.png",
+		Header:   textproto.MIMEHeader{"Content-Type": []string{"image/png"}},
+	}
+
+	driver := NewMemoryDriver()
+	bucketService := NewWithDriver(driver)
+
+	url, err := bucketService.UploadPlayerAvatar(context.Background(), 1, testFile{bytes.NewReader(content)}, fileHeader)
+
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(url, "memory://players/1/avatars/"))
+	assert.True(t, strings.HasSuffix(url, ".png"))
+
+	stored, ok := driver.Get(strings.TrimPrefix(url, "memory://"))
+
+	assert.True(t, ok)
+	assert.Equal(t, content, stored)
+}
+
+func TestService_UploadTeamLogo(t *testing.T) {
+	content := append([]byte("\xFF\xD8\xFF"), "TEST_LOGO"...)
+	fileHeader := &multipart.FileHeader{
+		Filename: "logo.jpg",
+		Header:   textproto.MIMEHeader{"Content-Type": []string{"image/jpeg"}},
+	}
+
+	driver := NewMemoryDriver()
+	bucketService := NewWithDriver(driver)
+
+	url, err := bucketService.UploadTeamLogo(context.Background(), 2, testFile{bytes.NewReader(content)}, fileHeader)
+
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(url, "memory://teams/2/logos/"))
+	assert.True(t, strings.HasSuffix(url, ".jpg"))
+
+	stored, ok := driver.Get(strings.TrimPrefix(url, "memory://"))
+
+	assert.True(t, ok)
+	assert.Equal(t, content, stored)
+}
+
+func TestService_UploadPlayerAvatar_NotImage(t *testing.T) {
+	fileHeader := &multipart.FileHeader{
+		Filename: "avatar.png",
+		Header:   textproto.MIMEHeader{"Content-Type": []string{"image/png"}},
+	}
+
+	bucketService := NewWithDriver(NewMemoryDriver())
+
+	_, err := bucketService.UploadPlayerAvatar(context.Background(), 1, testFile{bytes.NewReader([]byte("<html><script>"))}, fileHeader)
+
+	assert.Equal(t, validation.Errors{{Field: "image", Code: validation.CodeInvalid}}, err)
+}
@@ -0,0 +1,16 @@
+package contract
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/logansua/nfl_app/auth"
+)
+
+// Team managers may sign contracts of their teams, the service checks the player is on the team
+func authorizeContract() endpoint.Middleware {
+	return auth.ManageTeams(func(_ context.Context, request interface{}) ([]*int, error) {
+		req := request.(createContractRequest)
+
+		return []*int{&req.Contract.TeamID}, nil
+	})
+}
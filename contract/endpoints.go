@@ -0,0 +1,121 @@
+package contract
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/utils"
+	"github.com/logansua/nfl_app/validation"
+)
+
+type Endpoints struct {
+	CreateContractEndpoint     endpoint.Endpoint
+	GetPlayerContractsEndpoint endpoint.Endpoint
+	GetTeamCapEndpoint         endpoint.Endpoint
+}
+
+func MakeServerEndpoints(s Service, authenticate endpoint.Middleware) Endpoints {
+	validate := validation.Middleware()
+
+	return Endpoints{
+		CreateContractEndpoint:     authenticate(authorizeContract()(validate(MakeCreateContractEndpoint(s)))),
+		GetPlayerContractsEndpoint: authenticate(MakeGetPlayerContractsEndpoint(s)),
+		GetTeamCapEndpoint:         authenticate(MakeGetTeamCapEndpoint(s)),
+	}
+}
+
+func (e Endpoints) CreateContract(ctx context.Context, c dto.ContractDTO) error {
+	request := createContractRequest{Contract: c}
+	response, err := e.CreateContractEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetPlayerContracts(ctx context.Context, playerID int) error {
+	request := getPlayerContractsRequest{playerID: playerID}
+	response, err := e.GetPlayerContractsEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetTeamCap(ctx context.Context, teamID, season int) error {
+	request := getTeamCapRequest{teamID: teamID, Season: season}
+	response, err := e.GetTeamCapEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+
+func MakeCreateContractEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(createContractRequest)
+
+		c := req.Contract
+
+		err = service.CreateContract(ctx, &c)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: c}, nil
+	}
+}
+func MakeGetPlayerContractsEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getPlayerContractsRequest)
+
+		var contracts []dto.ContractDTO
+
+		err = service.GetPlayerContracts(ctx, req.playerID, &contracts)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: contracts}, nil
+	}
+}
+func MakeGetTeamCapEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getTeamCapRequest)
+
+		var teamCap dto.TeamCapDTO
+
+		err = service.GetTeamCap(ctx, req.teamID, req.Season, &teamCap)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: teamCap}, nil
+	}
+}
+
+type createContractRequest struct {
+	Contract dto.ContractDTO
+}
+
+type getPlayerContractsRequest struct {
+	playerID int
+}
+
+type getTeamCapRequest struct {
+	teamID int
+	Season int
+}
@@ -0,0 +1,114 @@
+package contract
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/logansua/nfl_app/query"
+	"github.com/logansua/nfl_app/router"
+	"github.com/logansua/nfl_app/validation"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const seasonParam = "season"
+
+func GetServiceOptions(logger log.Logger) []httptransport.ServerOption {
+	return router.ServerOptions(logger)
+}
+
+func CreateRoutes(s Service, logger log.Logger, authenticate endpoint.Middleware) []router.Route {
+	endpoints := MakeServerEndpoints(s, authenticate)
+
+	options := GetServiceOptions(logger)
+
+	return []router.Route{
+		{
+			Name:        "Create contract",
+			Method:      http.MethodPost,
+			Path:        "/contracts",
+			StrictSlash: false,
+			Handler: httptransport.NewServer(
+				endpoints.CreateContractEndpoint,
+				decodeCreateContractRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get player contracts",
+			Method:      http.MethodGet,
+			Path:        "/players/{id}/contracts",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetPlayerContractsEndpoint,
+				decodeGetPlayerContractsRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get team cap",
+			Method:      http.MethodGet,
+			Path:        "/teams/{id}/cap",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetTeamCapEndpoint,
+				decodeGetTeamCapRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+	}
+}
+
+func decodeCreateContractRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req createContractRequest
+
+	if e := validation.DecodeJSON(r.Body, &req.Contract); e != nil {
+		return nil, e
+	}
+
+	return req, nil
+}
+func decodeGetPlayerContractsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req getPlayerContractsRequest
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.playerID = id
+
+	return req, nil
+}
+func decodeGetTeamCapRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req getTeamCapRequest
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Current season is used unless requested otherwise
+	season := time.Now().Year()
+
+	if value := r.URL.Query().Get(seasonParam); value != "" {
+		season, err = strconv.Atoi(value)
+
+		if err != nil {
+			return nil, query.InvalidParam(seasonParam, value, "must be a year")
+		}
+	}
+
+	req.teamID = id
+	req.Season = season
+
+	return req, nil
+}
@@ -0,0 +1,170 @@
+package contract
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/logansua/nfl_app/db"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+	"os"
+	"strconv"
+)
+
+// Salary cap used when SALARY_CAP isn't configured
+const defaultSalaryCap = 188200000
+
+// Signing would push the team over the salary cap in the season
+const CodeOverCap = "over_cap"
+
+// Service stores contracts of players and accounts them against the salary cap.
+type Service interface {
+	// Sign contract of the player with the current team, it must fit under the cap in every season
+	CreateContract(ctx context.Context, contract *dto.ContractDTO) error
+	// Get all contracts of the player
+	GetPlayerContracts(ctx context.Context, playerID int, contracts *[]dto.ContractDTO) error
+	// Get committed cap, dead money and remaining space of the team in the season
+	GetTeamCap(ctx context.Context, teamID, season int, teamCap *dto.TeamCapDTO) error
+}
+
+type service struct {
+	DB        *db.DB
+	SalaryCap int64
+}
+
+func New(dbService *db.DB) Service {
+	salaryCap, err := strconv.ParseInt(os.Getenv("SALARY_CAP"), 10, 64)
+
+	if err != nil || salaryCap <= 0 {
+		salaryCap = defaultSalaryCap
+	}
+
+	return &service{DB: dbService, SalaryCap: salaryCap}
+}
+
+func (s *service) CreateContract(ctx context.Context, contract *dto.ContractDTO) error {
+	c := models.NewContractModel(contract)
+
+	var p models.Player
+
+	err := s.DB.Repository.FindById(&p, c.PlayerID)
+
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return validation.Errors{{Field: "player_id", Code: validation.CodeNotFound}}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if p.TeamID == nil || *p.TeamID != c.TeamID {
+		return validation.Errors{{Field: "team_id", Code: validation.CodeNotOnTeam}}
+	}
+
+	err = s.DB.ContractRepository.CreateContract(&c, func(existing []models.Contract) error {
+		return checkCap(c, existing, s.SalaryCap)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	*contract = models.NewContractDTO(c)
+
+	return nil
+}
+
+// Player can't have two running contracts and the team must stay under the cap in every season
+// of the new contract. Existing contracts are those of the team or the player in the same seasons.
+func checkCap(c models.Contract, existing []models.Contract, salaryCap int64) error {
+	errs := validation.New()
+
+	for _, value := range existing {
+		if value.PlayerID == c.PlayerID && value.TerminatedSeason == nil {
+			errs.Add("player_id", validation.CodeTaken)
+
+			break
+		}
+	}
+
+	for key, year := range c.Years {
+		charged := c.CapHit(year.Season)
+
+		for _, value := range existing {
+			if value.TeamID == c.TeamID {
+				charged += value.CapHit(year.Season) + value.DeadMoney(year.Season)
+			}
+		}
+
+		errs.Check(charged <= salaryCap, fmt.Sprintf("years[%d]", key), CodeOverCap)
+	}
+
+	return errs.Err()
+}
+
+func (s *service) GetPlayerContracts(ctx context.Context, playerID int, contracts *[]dto.ContractDTO) error {
+	var p models.Player
+
+	err := s.DB.Repository.FindById(&p, playerID)
+
+	if err != nil {
+		return err
+	}
+
+	var c []models.Contract
+
+	err = s.DB.ContractRepository.FindPlayerContracts(playerID, &c)
+
+	*contracts = make([]dto.ContractDTO, len(c))
+
+	for key, value := range c {
+		(*contracts)[key] = models.NewContractDTO(value)
+	}
+
+	return err
+}
+
+func (s *service) GetTeamCap(ctx context.Context, teamID, season int, teamCap *dto.TeamCapDTO) error {
+	var t models.Team
+
+	err := s.DB.Repository.FindById(&t, teamID)
+
+	if err != nil {
+		return err
+	}
+
+	var c []models.Contract
+
+	err = s.DB.ContractRepository.FindTeamContracts(teamID, season, &c)
+
+	if err != nil {
+		return err
+	}
+
+	*teamCap = dto.TeamCapDTO{
+		TeamID:    teamID,
+		Season:    season,
+		SalaryCap: s.SalaryCap,
+		Charges:   make([]dto.CapChargeDTO, len(c)),
+	}
+
+	for key, value := range c {
+		charge := dto.CapChargeDTO{
+			ContractID: int(value.ID),
+			PlayerID:   value.PlayerID,
+			Player:     value.Player.Name,
+			CapHit:     value.CapHit(season),
+			DeadMoney:  value.DeadMoney(season),
+		}
+
+		teamCap.Committed += charge.CapHit
+		teamCap.DeadMoney += charge.DeadMoney
+		teamCap.Charges[key] = charge
+	}
+
+	teamCap.Space = teamCap.SalaryCap - teamCap.Committed - teamCap.DeadMoney
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package contract
+
+import (
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/validation"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCheckCap(t *testing.T) {
+	terminated := 2019
+	existing := []models.Contract{
+		{PlayerID: 2, TeamID: 1, Years: []models.ContractYear{{Season: 2019, BaseSalary: 60}, {Season: 2020, BaseSalary: 60}}},
+		{PlayerID: 3, TeamID: 1, TerminatedSeason: &terminated, Years: []models.ContractYear{{Season: 2019, BaseSalary: 30, Guaranteed: 30}}},
+		{PlayerID: 4, TeamID: 2, Years: []models.ContractYear{{Season: 2020, BaseSalary: 100}}},
+	}
+
+	contract := models.Contract{
+		PlayerID:     1,
+		TeamID:       1,
+		SigningBonus: 20,
+		Years:        []models.ContractYear{{Season: 2019}, {Season: 2020, BaseSalary: 20}},
+	}
+
+	assert.Nil(t, checkCap(contract, existing, 100))
+
+	contract.Years[1].BaseSalary = 31
+
+	assert.Equal(t, validation.Errors{{Field: "years[1]", Code: CodeOverCap}}, checkCap(contract, existing, 100))
+
+	existing = append(existing, models.Contract{PlayerID: 1, TeamID: 2, Years: []models.ContractYear{{Season: 2020}}})
+
+	assert.Equal(t, validation.Errors{
+		{Field: "player_id", Code: validation.CodeTaken},
+		{Field: "years[1]", Code: CodeOverCap},
+	}, checkCap(contract, existing, 100))
+}
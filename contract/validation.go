@@ -0,0 +1,39 @@
+package contract
+
+import (
+	"context"
+	"fmt"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+)
+
+const maxYears = 10
+
+func validateContract(c dto.ContractDTO) error {
+	errs := validation.New().
+		ID("player_id", c.PlayerID).
+		ID("team_id", c.TeamID).
+		Check(c.SigningBonus >= 0, "signing_bonus", validation.CodeMin).
+		Check(len(c.Years) > 0, "years", validation.CodeRequired).
+		Check(len(c.Years) <= maxYears, "years", validation.CodeMax)
+
+	for key, year := range c.Years {
+		field := fmt.Sprintf("years[%d].", key)
+
+		// Seasons of the contract follow each other
+		if key > 0 {
+			errs.Check(year.Season == c.Years[0].Season+key, field+"season", validation.CodeInvalid)
+		}
+
+		errs.
+			Check(year.BaseSalary >= 0, field+"base_salary", validation.CodeMin).
+			Check(year.Guaranteed >= 0, field+"guaranteed", validation.CodeMin).
+			Check(year.Guaranteed <= year.BaseSalary, field+"guaranteed", validation.CodeMax)
+	}
+
+	return errs.Err()
+}
+
+func (r createContractRequest) Validate(_ context.Context) error {
+	return validateContract(r.Contract)
+}
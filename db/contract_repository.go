@@ -0,0 +1,178 @@
+package db
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/logansua/nfl_app/models"
+)
+
+type ContractRepository interface {
+	FindPlayerContracts(playerID int, out *[]models.Contract) error
+	FindTeamContracts(teamID, season int, out *[]models.Contract) error
+	CreateContract(contract *models.Contract, check func(existing []models.Contract) error) error
+}
+
+type ContractTable struct {
+	DB *gorm.DB
+}
+
+func preloadYears(db *gorm.DB) *gorm.DB {
+	return db.Order("season ASC")
+}
+
+func (ct *ContractTable) FindPlayerContracts(playerID int, out *[]models.Contract) error {
+	return translate(ct.
+		DB.
+		Preload("Years", preloadYears).
+		Where("player_id = ?", playerID).
+		Order("id ASC").
+		Find(out).
+		Error)
+}
+
+// Contracts of the team covering the season with preloaded players
+func (ct *ContractTable) FindTeamContracts(teamID, season int, out *[]models.Contract) error {
+	return translate(ct.
+		DB.
+		Preload("Years", preloadYears).
+		Preload("Player").
+		Where("team_id = ?", teamID).
+		Where("id IN (SELECT contract_id FROM contract_years WHERE season = ?)", season).
+		Order("id ASC").
+		Find(out).
+		Error)
+}
+
+// Create the contract unless the check of team's contracts in the same seasons fails. Team is locked so
+// concurrent signings can't both fit under the cap. Error of the check is returned as is.
+func (ct *ContractTable) CreateContract(contract *models.Contract, check func(existing []models.Contract) error) error {
+	var checkErr error
+
+	err := WithTransaction(ct.DB, func(tx *gorm.DB) error {
+		var team models.Team
+
+		err := tx.
+			Set("gorm:query_option", "FOR UPDATE").
+			First(&team, contract.TeamID).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		seasons := make([]int, len(contract.Years))
+
+		for key, value := range contract.Years {
+			seasons[key] = value.Season
+		}
+
+		var existing []models.Contract
+
+		err = tx.
+			Preload("Years", preloadYears).
+			Where("team_id = ? OR player_id = ?", contract.TeamID, contract.PlayerID).
+			Where("id IN (SELECT contract_id FROM contract_years WHERE season IN (?))", seasons).
+			Find(&existing).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		if checkErr = check(existing); checkErr != nil {
+			return checkErr
+		}
+
+		return tx.Create(contract).Error
+	})
+
+	if checkErr != nil {
+		return checkErr
+	}
+
+	return translate(err)
+}
+
+// Contracts of released and traded players are terminated in the current league season. Traded players get
+// a contract with the new team for the remaining seasons while the bonus stays with the old team as dead money.
+func moveContracts(tx *gorm.DB, t *models.Transaction) error {
+	if t.FromTeamID == nil || models.SameID(t.FromTeamID, t.ToTeamID) {
+		return nil
+	}
+
+	var contracts []models.Contract
+
+	err := tx.
+		Preload("Years", preloadYears).
+		Where("player_id = ? AND team_id = ? AND terminated_season IS NULL", t.PlayerID, *t.FromTeamID).
+		Find(&contracts).
+		Error
+
+	if err != nil || len(contracts) == 0 {
+		return err
+	}
+
+	season, err := leagueSeason(tx)
+
+	if err != nil {
+		return err
+	}
+
+	for _, contract := range contracts {
+		terminated := season
+
+		// Contracts starting after the current season, or before any season is scheduled, accelerate into
+		// their first season
+		if len(contract.Years) > 0 && contract.Years[0].Season > terminated {
+			terminated = contract.Years[0].Season
+		}
+
+		err := tx.
+			Model(&models.Contract{}).
+			Where("id = ?", contract.ID).
+			Updates(map[string]interface{}{"terminated_season": terminated, "traded": t.ToTeamID != nil}).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		if t.ToTeamID == nil {
+			continue
+		}
+
+		remaining := models.Contract{PlayerID: contract.PlayerID, TeamID: *t.ToTeamID}
+
+		for _, year := range contract.Years {
+			if year.Season >= terminated {
+				remaining.Years = append(remaining.Years, models.ContractYear{
+					Season:     year.Season,
+					BaseSalary: year.BaseSalary,
+					Guaranteed: year.Guaranteed,
+				})
+			}
+		}
+
+		if len(remaining.Years) == 0 {
+			continue
+		}
+
+		if err := tx.Create(&remaining).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Year of the latest season of the league, zero when no season is scheduled yet
+func leagueSeason(tx *gorm.DB) (int, error) {
+	var season models.Season
+
+	err := tx.Order("year DESC").First(&season).Error
+
+	if gorm.IsRecordNotFoundError(err) {
+		return 0, nil
+	}
+
+	return season.Year, err
+}
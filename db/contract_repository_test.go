@@ -0,0 +1,57 @@
+package db
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+	"github.com/logansua/nfl_app/models"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+)
+
+func TestMoveContracts_Trade(t *testing.T) {
+	db, mock := newMockDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "contracts" WHERE (player_id = $1 AND team_id = $2 AND terminated_season IS NULL)`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "player_id", "team_id", "signing_bonus"}).AddRow(5, 1, 1, 3000))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "contract_years" WHERE ("contract_id" IN ($1)) ORDER BY season ASC`)).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "contract_id", "season", "base_salary", "guaranteed"}).
+			AddRow(1, 5, 2019, 100, 100).
+			AddRow(2, 5, 2020, 200, 50).
+			AddRow(3, 5, 2021, 300, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "seasons"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "year"}).AddRow(2, 2020))
+	// Old contract is terminated in the league season and only its bonus accelerates
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "contracts" SET "terminated_season" = $1, "traded" = $2, "updated_at" = $3 WHERE (id = $4)`)).
+		WithArgs(2020, true, sqlmock.AnyArg(), 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// New team takes over salaries of the remaining seasons
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "contracts"`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, 2, 0, nil, false).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(6))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "contract_years"`)).
+		WithArgs(6, 2020, 200, 50).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "contract_years"`)).
+		WithArgs(6, 2021, 300, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	mock.ExpectCommit()
+
+	fromTeamID, toTeamID := 1, 2
+
+	err := WithTransaction(db, func(tx *gorm.DB) error {
+		return moveContracts(tx, &models.Transaction{
+			Type:       models.TransactionTrade,
+			PlayerID:   1,
+			FromTeamID: &fromTeamID,
+			ToTeamID:   &toTeamID,
+		})
+	})
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,74 @@
+package db
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/logansua/nfl_app/models"
+)
+
+type DepthChartRepository interface {
+	FindTeamDepthChart(teamID int, out *[]models.DepthChartEntry) error
+	ReplaceTeamDepthChart(teamID int, entries []models.DepthChartEntry) error
+}
+
+type DepthChartTable struct {
+	DB *gorm.DB
+}
+
+// Entries of the team with preloaded players, ordered by depth
+func (dt *DepthChartTable) FindTeamDepthChart(teamID int, out *[]models.DepthChartEntry) error {
+	return translate(dt.
+		DB.
+		Preload("Player").
+		Where("team_id = ?", teamID).
+		Order("position ASC, depth ASC").
+		Find(out).
+		Error)
+}
+
+// Replace the whole depth chart of the team. Players are locked so none of them can leave the team
+// until the chart is written.
+func (dt *DepthChartTable) ReplaceTeamDepthChart(teamID int, entries []models.DepthChartEntry) error {
+	return translate(WithTransaction(dt.DB, func(tx *gorm.DB) error {
+		ids := make([]int, len(entries))
+
+		for key, value := range entries {
+			ids[key] = value.PlayerID
+		}
+
+		var players []models.Player
+
+		err := tx.
+			Set("gorm:query_option", "FOR SHARE").
+			Where("id IN (?)", ids).
+			Order("id ASC").
+			Find(&players).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		for _, value := range players {
+			if value.TeamID == nil || *value.TeamID != teamID {
+				return ErrRosterChanged
+			}
+		}
+
+		err = tx.
+			Where("team_id = ?", teamID).
+			Delete(&models.DepthChartEntry{}).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		for key := range entries {
+			if err := tx.Create(&entries[key]).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}))
+}
@@ -0,0 +1,74 @@
+package db
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+	"github.com/logansua/nfl_app/models"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+)
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+
+	assert.Nil(t, err)
+
+	db, err := gorm.Open("postgres", sqlDB)
+
+	assert.Nil(t, err)
+
+	return db, mock
+}
+
+func TestPlayer_AfterUpdate(t *testing.T) {
+	db, mock := newMockDB(t)
+	defer db.Close()
+
+	teamID := 2
+
+	// Player who moved to another team is removed from depth charts of the others
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "players"`)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "depth_chart_entries" WHERE (player_id = $1) AND (team_id <> $2)`)).
+		WithArgs(1, teamID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Free agent is removed from all depth charts
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "players"`)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "depth_chart_entries" WHERE (player_id = $1)`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repository := &BaseRepository{DB: db}
+
+	assert.Nil(t, repository.Save(&models.Player{ID: 1, Name: "TEST_PLAYER", TeamID: &teamID}))
+	assert.Nil(t, repository.Save(&models.Player{ID: 1, Name: "TEST_PLAYER"}))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestReplaceTeamDepthChart_RosterChanged(t *testing.T) {
+	db, mock := newMockDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "players" WHERE \(id IN \(\$1,\$2\)\) ORDER BY id ASC FOR SHARE`).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "team_id"}).
+			AddRow(1, "TEST_PLAYER_1", 1).
+			AddRow(2, "TEST_PLAYER_2", 3))
+	mock.ExpectRollback()
+
+	repository := &DepthChartTable{DB: db}
+
+	err := repository.ReplaceTeamDepthChart(1, []models.DepthChartEntry{
+		{TeamID: 1, Position: models.PositionQuarterback, Depth: 1, PlayerID: 1},
+		{TeamID: 1, Position: models.PositionQuarterback, Depth: 2, PlayerID: 2},
+	})
+
+	assert.Equal(t, ErrRosterChanged, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
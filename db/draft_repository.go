@@ -0,0 +1,155 @@
+package db
+
+import (
+	"fmt"
+	"github.com/jinzhu/gorm"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/models"
+	"time"
+)
+
+// ErrDraftExists is returned when picks of the season were already created
+var ErrDraftExists = apperrors.New(apperrors.KindAlreadyExists, "draft of the season already exists")
+
+// ErrNotOnClock is returned when the pick was already made or an earlier pick wasn't made yet
+var ErrNotOnClock = apperrors.New(apperrors.KindAlreadyExists, "pick is not on the clock")
+
+type DraftRepository interface {
+	FindSeasonDraft(seasonID int, out *[]models.DraftPick) error
+	FindPick(seasonID, overall int, out *models.DraftPick) error
+	CreateDraft(seasonID int, picks []models.DraftPick) error
+	SelectPlayer(pick *models.DraftPick, player *models.Player) error
+}
+
+type DraftTable struct {
+	DB *gorm.DB
+}
+
+// Picks of the season in order of selection with preloaded players
+func (dt *DraftTable) FindSeasonDraft(seasonID int, out *[]models.DraftPick) error {
+	return translate(dt.
+		DB.
+		Preload("Season").
+		Preload("Player").
+		Where("season_id = ?", seasonID).
+		Order("overall ASC").
+		Find(out).
+		Error)
+}
+
+func (dt *DraftTable) FindPick(seasonID, overall int, out *models.DraftPick) error {
+	return translate(dt.
+		DB.
+		Preload("Season").
+		Where("season_id = ? AND overall = ?", seasonID, overall).
+		First(out).
+		Error)
+}
+
+// Create all picks of the season at once, season is locked so the draft can't be created twice
+func (dt *DraftTable) CreateDraft(seasonID int, picks []models.DraftPick) error {
+	return translate(WithTransaction(dt.DB, func(tx *gorm.DB) error {
+		var season models.Season
+
+		err := tx.
+			Set("gorm:query_option", "FOR UPDATE").
+			First(&season, seasonID).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		var count int
+
+		err = tx.
+			Model(&models.DraftPick{}).
+			Where("season_id = ?", seasonID).
+			Count(&count).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return ErrDraftExists
+		}
+
+		for key := range picks {
+			if err := tx.Create(&picks[key]).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}))
+}
+
+// Create the player on the team owning the pick and record the draft transaction. Pick is locked
+// and must be the first one of the season not made yet.
+func (dt *DraftTable) SelectPlayer(pick *models.DraftPick, player *models.Player) error {
+	return translate(WithTransaction(dt.DB, func(tx *gorm.DB) error {
+		err := tx.
+			Set("gorm:query_option", "FOR UPDATE").
+			First(pick, pick.ID).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		if pick.PlayerID != nil {
+			return ErrNotOnClock
+		}
+
+		var earlier int
+
+		err = tx.
+			Model(&models.DraftPick{}).
+			Where("season_id = ? AND overall < ? AND player_id IS NULL", pick.SeasonID, pick.Overall).
+			Count(&earlier).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		if earlier > 0 {
+			return ErrNotOnClock
+		}
+
+		teamID := pick.OwnerTeamID
+		player.TeamID = &teamID
+
+		if err := tx.Create(player).Error; err != nil {
+			return err
+		}
+
+		playerID := int(player.ID)
+		selectedAt := time.Now()
+
+		err = tx.
+			Set("gorm:save_associations", false).
+			Model(pick).
+			Updates(map[string]interface{}{
+				"player_id":   &playerID,
+				"selected_at": &selectedAt,
+			}).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		pick.Player = *player
+
+		return tx.Create(&models.Transaction{
+			Type:     models.TransactionDraft,
+			PlayerID: playerID,
+			ToTeamID: &teamID,
+			Status:   player.Status,
+			Note:     fmt.Sprintf("Round %d, pick %d", pick.Round, pick.Overall),
+		}).Error
+	}))
+}
@@ -0,0 +1,51 @@
+package db
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	apperrors "github.com/logansua/nfl_app/errors"
+)
+
+// PostgreSQL error codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+// Players with transaction history and teams with players can't be deleted
+var ErrReferenced = apperrors.New(apperrors.KindAlreadyExists, "record is still referenced")
+
+// Translate gorm and driver errors into application errors
+func translate(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := err.(*apperrors.Error); ok {
+		return err
+	}
+
+	if gorm.IsRecordNotFoundError(err) {
+		return apperrors.Wrap(apperrors.KindNotFound, apperrors.ErrNotFound.Message, err)
+	}
+
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code {
+		case uniqueViolation:
+			return apperrors.Wrap(apperrors.KindAlreadyExists, apperrors.ErrAlreadyExists.Message, err)
+		case foreignKeyViolation:
+			return apperrors.Wrap(apperrors.KindInvalidArgument, "referenced record does not exist", err)
+		}
+	}
+
+	return apperrors.Wrap(apperrors.KindInternal, "database error", err)
+}
+
+// Translate errors of deletes, foreign key violation means the row is referenced rather than missing
+func translateDelete(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == foreignKeyViolation {
+		return apperrors.Wrap(ErrReferenced.Kind, ErrReferenced.Message, err)
+	}
+
+	return translate(err)
+}
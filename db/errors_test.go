@@ -0,0 +1,18 @@
+package db
+
+import (
+	"errors"
+	"github.com/lib/pq"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTranslateDelete(t *testing.T) {
+	err := &pq.Error{Code: foreignKeyViolation}
+
+	assert.True(t, errors.Is(translateDelete(err), ErrReferenced))
+	assert.True(t, errors.Is(translate(err), apperrors.ErrInvalidArgument))
+	assert.True(t, errors.Is(translateDelete(&pq.Error{Code: uniqueViolation}), apperrors.ErrAlreadyExists))
+	assert.Nil(t, translateDelete(nil))
+}
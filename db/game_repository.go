@@ -0,0 +1,133 @@
+package db
+
+import (
+	"github.com/jinzhu/gorm"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
+)
+
+// Query parameters allowed for listing
+var GameQueryFields = query.Fields{
+	"week":       {Column: "week", Type: query.Int, Filter: query.Equal, Sortable: true},
+	"status":     {Column: "status", Type: query.String, Filter: query.Equal, Sortable: true},
+	"kickoff_at": {Column: "kickoff_at", Sortable: true},
+	"id":         {Column: "id", Type: query.Int, Sortable: true},
+}
+
+// ErrDoubleBooked is returned when a team already has a game in the week
+var ErrDoubleBooked = apperrors.New(apperrors.KindAlreadyExists, "team already has a game in this week")
+
+type GameRepository interface {
+	FindAllAndPaginate(paging pagination.Pagination, q query.Query, out *[]models.Game, meta *pagination.Meta) error
+	FindTeamGames(teamID int, paging pagination.Pagination, q query.Query, out *[]models.Game, meta *pagination.Meta) error
+	FindGame(id int, out *models.Game) error
+	FindSeasonByYear(year int, out *models.Season) error
+	FindOrCreateSeason(year int, out *models.Season) error
+	FindSeasonResults(seasonID int, out *[]models.Game) error
+	CreateGame(game *models.Game) error
+	UpdateResult(game *models.Game) error
+}
+
+type GameTable struct {
+	DB *gorm.DB
+}
+
+func (gt *GameTable) FindAllAndPaginate(paging pagination.Pagination, q query.Query, out *[]models.Game, meta *pagination.Meta) error {
+	return translate(findPage(gt.DB, paging, q, &models.Game{}, out, meta))
+}
+
+func (gt *GameTable) FindTeamGames(teamID int, paging pagination.Pagination, q query.Query, out *[]models.Game, meta *pagination.Meta) error {
+	teamGames := gt.DB.Where("home_team_id = ? OR away_team_id = ?", teamID, teamID)
+
+	return translate(findPage(teamGames, paging, q, &models.Game{}, out, meta))
+}
+
+func (gt *GameTable) FindGame(id int, out *models.Game) error {
+	return translate(gt.
+		DB.
+		Preload("Season").
+		First(out, id).
+		Error)
+}
+
+func (gt *GameTable) FindSeasonByYear(year int, out *models.Season) error {
+	return translate(gt.
+		DB.
+		Where("year = ?", year).
+		First(out).
+		Error)
+}
+
+func (gt *GameTable) FindOrCreateSeason(year int, out *models.Season) error {
+	return translate(gt.
+		DB.
+		Where(models.Season{Year: year}).
+		FirstOrCreate(out).
+		Error)
+}
+
+// Final games of the season in order of kickoff
+func (gt *GameTable) FindSeasonResults(seasonID int, out *[]models.Game) error {
+	return translate(gt.
+		DB.
+		Where("season_id = ? AND status = ?", seasonID, models.GameFinal).
+		Order("kickoff_at ASC").
+		Order("id ASC").
+		Find(out).
+		Error)
+}
+
+// Create game unless one of the teams already plays in the same week of the season
+func (gt *GameTable) CreateGame(game *models.Game) error {
+	return translate(WithTransaction(gt.DB, func(tx *gorm.DB) error {
+		teamIDs := []int{game.HomeTeamID, game.AwayTeamID}
+
+		// Lock both teams so concurrent requests can't book them for the same week
+		var teams []models.Team
+
+		err := tx.
+			Set("gorm:query_option", "FOR UPDATE").
+			Where("id IN (?)", teamIDs).
+			Order("id ASC").
+			Find(&teams).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		var count int
+
+		err = tx.
+			Model(&models.Game{}).
+			Where("season_id = ? AND week = ? AND status <> ?", game.SeasonID, game.Week, models.GameCanceled).
+			Where("home_team_id IN (?) OR away_team_id IN (?)", teamIDs, teamIDs).
+			Count(&count).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return ErrDoubleBooked
+		}
+
+		return tx.Create(game).Error
+	}))
+}
+
+// Store status and score of the game
+func (gt *GameTable) UpdateResult(game *models.Game) error {
+	return translate(gt.
+		DB.
+		Model(game).
+		Updates(map[string]interface{}{
+			"status":     game.Status,
+			"home_score": game.HomeScore,
+			"away_score": game.AwayScore,
+		}).
+		Error)
+}
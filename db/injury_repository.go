@@ -0,0 +1,63 @@
+package db
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
+)
+
+// Query parameters allowed for listing
+var InjuryQueryFields = query.Fields{
+	"player_id":       {Column: "player_id", Type: query.Int, Filter: query.Equal, Sortable: true},
+	"body_part":       {Column: "body_part", Type: query.String, Filter: query.Contains, Sortable: true},
+	"game_status":     {Column: "game_status", Type: query.String, Filter: query.Equal, Sortable: true},
+	"practice_status": {Column: "practice_status", Type: query.String, Filter: query.Equal, Sortable: true},
+	"reported_on":     {Column: "reported_on", Sortable: true},
+	"id":              {Column: "id", Type: query.Int, Sortable: true},
+}
+
+type InjuryRepository interface {
+	FindTeamInjuries(teamID int, paging pagination.Pagination, q query.Query, out *[]models.Injury, meta *pagination.Meta) error
+	FindWeeklyReport(seasonID, week int, gameStatus string, out *[]models.Injury) error
+}
+
+type InjuryTable struct {
+	DB *gorm.DB
+}
+
+// Open injuries of players reported on the team
+func (it *InjuryTable) FindTeamInjuries(teamID int, paging pagination.Pagination, q query.Query, out *[]models.Injury, meta *pagination.Meta) error {
+	teamInjuries := it.DB.
+		Preload("Player").
+		Where("team_id = ? AND resolved_on IS NULL", teamID)
+
+	return translate(findPage(teamInjuries, paging, q, &models.Injury{}, out, meta))
+}
+
+// Injuries of teams playing in the week which were open on the day of their game. All game statuses
+// are included when it is empty.
+func (it *InjuryTable) FindWeeklyReport(seasonID, week int, gameStatus string, out *[]models.Injury) error {
+	report := it.
+		DB.
+		Preload("Player").
+		Select("injuries.*").
+		Joins(
+			"JOIN games ON games.season_id = ? AND games.week = ? AND games.status <> ? "+
+				"AND injuries.team_id IN (games.home_team_id, games.away_team_id)",
+			seasonID, week, models.GameCanceled,
+		).
+		Where("injuries.reported_on <= CAST(games.kickoff_at AS DATE)").
+		Where("injuries.resolved_on IS NULL OR injuries.resolved_on > CAST(games.kickoff_at AS DATE)")
+
+	if gameStatus != "" {
+		report = report.Where("injuries.game_status = ?", gameStatus)
+	}
+
+	return translate(report.
+		Order("injuries.team_id ASC").
+		Order("CASE injuries.game_status WHEN 'out' THEN 0 WHEN 'doubtful' THEN 1 ELSE 2 END").
+		Order("injuries.id ASC").
+		Find(out).
+		Error)
+}
@@ -0,0 +1,67 @@
+package db
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/query"
+)
+
+// Query parameters allowed for listing divisions
+var DivisionQueryFields = query.Fields{
+	"conference_id": {Column: "conference_id", Type: query.Int, Filter: query.Equal},
+	"conference":    {Column: "(SELECT conferences.name FROM conferences WHERE conferences.id = divisions.conference_id)", Filter: query.Equal},
+	"name":          {Column: "name", Filter: query.Equal, Sortable: true},
+	"id":            {Column: "id", Type: query.Int, Sortable: true},
+}
+
+type LeagueRepository interface {
+	FindConferences(out *[]models.Conference) error
+	FindConference(id int, out *models.Conference) error
+	FindDivisions(q query.Query, out *[]models.Division) error
+	FindDivision(id int, out *models.Division) error
+}
+
+type LeagueTable struct {
+	DB *gorm.DB
+}
+
+func orderDivisions(db *gorm.DB) *gorm.DB {
+	return db.Order("name ASC").Order("id ASC")
+}
+
+func (lt *LeagueTable) FindConferences(out *[]models.Conference) error {
+	return translate(lt.
+		DB.
+		Preload("Divisions", orderDivisions).
+		Order("name ASC").
+		Order("id ASC").
+		Find(out).
+		Error)
+}
+
+func (lt *LeagueTable) FindConference(id int, out *models.Conference) error {
+	return translate(lt.
+		DB.
+		Preload("Divisions", orderDivisions).
+		First(out, id).
+		Error)
+}
+
+func (lt *LeagueTable) FindDivisions(q query.Query, out *[]models.Division) error {
+	if len(q.Sort) == 0 {
+		q.Sort = []query.Sort{{Column: "conference_id"}, {Column: "name"}}
+	}
+
+	return translate(q.
+		Apply(lt.DB.Preload("Conference")).
+		Find(out).
+		Error)
+}
+
+func (lt *LeagueTable) FindDivision(id int, out *models.Division) error {
+	return translate(lt.
+		DB.
+		Preload("Conference").
+		First(out, id).
+		Error)
+}
@@ -0,0 +1,134 @@
+package db
+
+import (
+	"fmt"
+	"github.com/jinzhu/gorm"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
+	"reflect"
+)
+
+// Find page of rows matching the query, model is used for counting and out must be a pointer to slice
+func findPage(db *gorm.DB, paging pagination.Pagination, q query.Query, model interface{}, out interface{}, meta *pagination.Meta) error {
+	if paging.Keyset {
+		return findKeysetPage(db, paging, q, out, meta)
+	}
+
+	return WithSnapshot(db, func(tx *gorm.DB) error {
+		var total int
+
+		err := q.
+			Where(tx.Model(model)).
+			Count(&total).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		*meta = paging.Meta(total)
+
+		return q.
+			Apply(tx).
+			Offset(paging.Offset).
+			Limit(paging.Limit).
+			Find(out).
+			Error
+	})
+}
+
+// Keyset pagination, rows are selected with WHERE (key, id) > (...) instead of OFFSET
+func findKeysetPage(db *gorm.DB, paging pagination.Pagination, q query.Query, out interface{}, meta *pagination.Meta) error {
+	if len(q.Sort) > 1 {
+		return query.InvalidParam("sort", q.Sort[1].String(), "cursor pagination supports a single sort field")
+	}
+
+	key := query.Sort{Column: "id"}
+
+	if len(q.Sort) == 1 {
+		key = q.Sort[0]
+	}
+
+	direction, operator := "ASC", ">"
+
+	if key.Desc {
+		direction, operator = "DESC", "<"
+	}
+
+	stmt := q.Preloads(q.Where(db))
+
+	if cursor := paging.Cursor; cursor != nil {
+		if cursor.Sort != key.String() {
+			return apperrors.ErrInvalidCursor
+		}
+
+		condition, values := keysetCondition(key, operator, *cursor)
+
+		stmt = stmt.Where(condition, values...)
+	}
+
+	if key.Column != "id" {
+		stmt = stmt.Order(key.Clause())
+	}
+
+	// One extra row is selected to find out if there is a next page
+	err := stmt.
+		Order(fmt.Sprintf("id %s", direction)).
+		Limit(paging.Limit + 1).
+		Find(out).
+		Error
+
+	if err != nil {
+		return err
+	}
+
+	rows := reflect.ValueOf(out).Elem()
+
+	if rows.Len() <= paging.Limit {
+		*meta = paging.CursorMeta(nil)
+
+		return nil
+	}
+
+	rows.Set(rows.Slice(0, paging.Limit))
+
+	last := db.NewScope(rows.Index(paging.Limit - 1).Addr().Interface())
+	next := &pagination.Cursor{Sort: key.String()}
+
+	if id, ok := last.PrimaryKeyValue().(uint); ok {
+		next.ID = id
+	}
+
+	if key.Column != "id" {
+		field, ok := last.FieldByName(key.Column)
+
+		if !ok {
+			return fmt.Errorf("unknown keyset column %s", key.Column)
+		}
+
+		// NULL key is kept as nil so the next page continues among NULL rows
+		if v := field.Field; v.Kind() != reflect.Ptr || !v.IsNil() {
+			next.Key = v.Interface()
+		}
+	}
+
+	*meta = paging.CursorMeta(next)
+
+	return nil
+}
+
+// Rows after the cursor. NULL keys are sorted last in both directions, so they follow every non-NULL
+// key and among themselves are ordered by id only.
+func keysetCondition(key query.Sort, operator string, cursor pagination.Cursor) (string, []interface{}) {
+	switch {
+	case key.Column == "id":
+		return fmt.Sprintf("id %s ?", operator), []interface{}{cursor.ID}
+	case !key.Nullable:
+		return fmt.Sprintf("(%s, id) %s (?, ?)", key.Column, operator), []interface{}{cursor.Key, cursor.ID}
+	case cursor.Key == nil:
+		return fmt.Sprintf("%s IS NULL AND id %s ?", key.Column, operator), []interface{}{cursor.ID}
+	default:
+		return fmt.Sprintf("((%s, id) %s (?, ?) OR %s IS NULL)", key.Column, operator, key.Column), []interface{}{cursor.Key, cursor.ID}
+	}
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestKeysetCondition(t *testing.T) {
+	cases := []struct {
+		key       query.Sort
+		operator  string
+		cursor    pagination.Cursor
+		condition string
+		values    []interface{}
+	}{
+		{
+			key:       query.Sort{Column: "id"},
+			operator:  ">",
+			cursor:    pagination.Cursor{Sort: "id", ID: 7},
+			condition: "id > ?",
+			values:    []interface{}{uint(7)},
+		},
+		{
+			key:       query.Sort{Column: "name"},
+			operator:  ">",
+			cursor:    pagination.Cursor{Sort: "name", Key: "Brady", ID: 7},
+			condition: "(name, id) > (?, ?)",
+			values:    []interface{}{"Brady", uint(7)},
+		},
+		{
+			key:       query.Sort{Column: "team_id", Desc: true, Nullable: true},
+			operator:  "<",
+			cursor:    pagination.Cursor{Sort: "-team_id", Key: float64(3), ID: 7},
+			condition: "((team_id, id) < (?, ?) OR team_id IS NULL)",
+			values:    []interface{}{float64(3), uint(7)},
+		},
+		{
+			key:       query.Sort{Column: "team_id", Nullable: true},
+			operator:  ">",
+			cursor:    pagination.Cursor{Sort: "team_id", ID: 7},
+			condition: "team_id IS NULL AND id > ?",
+			values:    []interface{}{uint(7)},
+		},
+	}
+
+	for _, c := range cases {
+		condition, values := keysetCondition(c.key, c.operator, c.cursor)
+
+		assert.Equal(t, c.condition, condition)
+		assert.Equal(t, c.values, values)
+	}
+}
@@ -4,22 +4,48 @@ import (
 	"github.com/jinzhu/gorm"
 	"github.com/logansua/nfl_app/models"
 	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
 )
 
+// Query parameters allowed for listing
+var PlayerQueryFields = query.Fields{
+	"team_id":       {Column: "team_id", Type: query.Int, Filter: query.Equal, Sortable: true, Nullable: true},
+	"name":          {Column: "name", Type: query.String, Filter: query.Contains, Sortable: true},
+	"position":      {Column: "position", Type: query.String, Filter: query.Equal, Sortable: true},
+	"jersey_number": {Column: "jersey_number", Type: query.Int, Filter: query.Equal, Sortable: true, Nullable: true},
+	"status":        {Column: "status", Type: query.String, Filter: query.Equal, Sortable: true},
+	"id":            {Column: "id", Type: query.Int, Sortable: true},
+	"created_at":    {Column: "created_at", Sortable: true},
+	"updated_at":    {Column: "updated_at", Sortable: true},
+}
+
 type PlayerRepository interface {
-	FindAllAndPaginate(paging pagination.Pagination, out *[]models.Player) error
+	FindAllAndPaginate(paging pagination.Pagination, q query.Query, out *[]models.Player, meta *pagination.Meta) error
+	FindWithTeam(id int, out *models.Player) error
+	FindActiveByJerseyNumber(teamID, jerseyNumber int, out *models.Player) error
 }
 
 type PlayerTable struct {
 	DB *gorm.DB
 }
 
-func (pt *PlayerTable) FindAllAndPaginate(paging pagination.Pagination, out *[]models.Player) error {
-	return pt.
+func (pt *PlayerTable) FindAllAndPaginate(paging pagination.Pagination, q query.Query, out *[]models.Player, meta *pagination.Meta) error {
+	return translate(findPage(pt.DB, paging, q, &models.Player{}, out, meta))
+}
+
+func (pt *PlayerTable) FindWithTeam(id int, out *models.Player) error {
+	return translate(pt.
+		DB.
+		Preload("Team").
+		First(out, id).
+		Error)
+}
+
+// Find player of the team's active roster wearing the number
+func (pt *PlayerTable) FindActiveByJerseyNumber(teamID, jerseyNumber int, out *models.Player) error {
+	return translate(pt.
 		DB.
-		Offset(paging.Offset).
-		Limit(paging.Limit).
-		Find(out).
-		Order("id ASC").
-		Error
+		Where("team_id = ? AND jersey_number = ? AND status = ?", teamID, jerseyNumber, models.PlayerActive).
+		First(out).
+		Error)
 }
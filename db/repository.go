@@ -5,6 +5,7 @@ import "github.com/jinzhu/gorm"
 type Repository interface {
 	FindById(model interface{}, id int) error
 	FindAll(model interface{}) error
+	FindByIds(model interface{}, ids []int) error
 	Delete(model interface{}, id int) error
 	Create(model interface{}) error
 	Save(model interface{}) error
@@ -15,21 +16,25 @@ type BaseRepository struct {
 }
 
 func (r *BaseRepository) FindById(model interface{}, id int) error {
-	return r.DB.First(model, id).Error
+	return translate(r.DB.First(model, id).Error)
 }
 
 func (r *BaseRepository) FindAll(model interface{}) error {
-	return r.DB.Find(model).Error
+	return translate(r.DB.Find(model).Error)
+}
+
+func (r *BaseRepository) FindByIds(model interface{}, ids []int) error {
+	return translate(r.DB.Where("id IN (?)", ids).Find(model).Error)
 }
 
 func (r *BaseRepository) Delete(model interface{}, id int) error {
-	return r.DB.Delete(model, id).Error
+	return translateDelete(r.DB.Delete(model, id).Error)
 }
 
 func (r *BaseRepository) Create(model interface{}) error {
-	return r.DB.Create(model).Error
+	return translate(r.DB.Create(model).Error)
 }
 
 func (r *BaseRepository) Save(model interface{}) error {
-	return r.DB.Save(model).Error
+	return translate(r.DB.Save(model).Error)
 }
@@ -8,10 +8,19 @@ import (
 )
 
 type DB struct {
-	Repository       Repository
-	PlayerRepository PlayerRepository
-	TeamRepository   TeamRepository
-	DB               *gorm.DB
+	Repository            Repository
+	PlayerRepository      PlayerRepository
+	TeamRepository        TeamRepository
+	GameRepository        GameRepository
+	LeagueRepository      LeagueRepository
+	StatRepository        StatRepository
+	TransactionRepository TransactionRepository
+	DepthChartRepository  DepthChartRepository
+	InjuryRepository      InjuryRepository
+	DraftRepository       DraftRepository
+	ContractRepository    ContractRepository
+	UserRepository        UserRepository
+	DB                    *gorm.DB
 }
 
 // Initialize connection to database
@@ -22,15 +31,20 @@ func New() (*DB, error) {
 		return nil, err
 	}
 
-	//db.DropTable(&models.Player{}, &models.Team{})
-	//db.AutoMigrate(&models.Player{}, &models.Team{})
-	//db.Model(&models.Player{}).AddForeignKey("team_id", "teams(id)", "CASCADE", "NO ACTION")
-
 	return &DB{
-		Repository:       &BaseRepository{DB: db},
-		PlayerRepository: &PlayerTable{DB: db},
-		TeamRepository:   &TeamTable{DB: db},
-		DB:               db,
+		Repository:            &BaseRepository{DB: db},
+		PlayerRepository:      &PlayerTable{DB: db},
+		TeamRepository:        &TeamTable{DB: db},
+		GameRepository:        &GameTable{DB: db},
+		LeagueRepository:      &LeagueTable{DB: db},
+		StatRepository:        &StatTable{DB: db},
+		TransactionRepository: &TransactionTable{DB: db},
+		DepthChartRepository:  &DepthChartTable{DB: db},
+		InjuryRepository:      &InjuryTable{DB: db},
+		DraftRepository:       &DraftTable{DB: db},
+		ContractRepository:    &ContractTable{DB: db},
+		UserRepository:        &UserTable{DB: db},
+		DB:                    db,
 	}, nil
 }
 
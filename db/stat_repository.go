@@ -0,0 +1,106 @@
+package db
+
+import (
+	"fmt"
+	"github.com/jinzhu/gorm"
+	"github.com/logansua/nfl_app/models"
+	"strings"
+)
+
+type StatRepository interface {
+	SaveStatLines(lines []models.StatLine) error
+	FindPlayerTotals(playerID, year int, byWeek bool, out *[]models.StatTotals) error
+	FindLeaders(seasonID int, stat string, limit int, out *[]models.Leader) error
+}
+
+type StatTable struct {
+	DB *gorm.DB
+}
+
+// Stat line of the same player and game is replaced with the new one
+var upsertStatLine = func() string {
+	columns := append([]string{"team_id", "updated_at"}, models.StatColumns...)
+	assignments := make([]string, len(columns))
+
+	for key, column := range columns {
+		assignments[key] = fmt.Sprintf("%s = EXCLUDED.%s", column, column)
+	}
+
+	return "ON CONFLICT (player_id, game_id) DO UPDATE SET " + strings.Join(assignments, ", ")
+}()
+
+// Insert or replace all stat lines in a single transaction
+func (st *StatTable) SaveStatLines(lines []models.StatLine) error {
+	return translate(WithTransaction(st.DB, func(tx *gorm.DB) error {
+		for key := range lines {
+			err := tx.
+				Set("gorm:insert_option", upsertStatLine).
+				Create(&lines[key]).
+				Error
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}))
+}
+
+// Totals of the player per season or per week, all seasons are returned when year is zero
+func (st *StatTable) FindPlayerTotals(playerID, year int, byWeek bool, out *[]models.StatTotals) error {
+	groups := []string{"seasons.year"}
+	columns := []string{"seasons.year AS season"}
+
+	if byWeek {
+		groups = append(groups, "games.week")
+		columns = append(columns, "games.week AS week")
+	}
+
+	columns = append(columns, "COUNT(*) AS games")
+
+	for _, column := range models.StatColumns {
+		columns = append(columns, fmt.Sprintf("SUM(stat_lines.%s) AS %s", column, column))
+	}
+
+	stmt := st.
+		DB.
+		Table("stat_lines").
+		Select(strings.Join(columns, ", ")).
+		Joins("JOIN games ON games.id = stat_lines.game_id").
+		Joins("JOIN seasons ON seasons.id = games.season_id").
+		Where("stat_lines.player_id = ?", playerID)
+
+	if year != 0 {
+		stmt = stmt.Where("seasons.year = ?", year)
+	}
+
+	return translate(stmt.
+		Group(strings.Join(groups, ", ")).
+		Order(strings.Join(groups, ", ")).
+		Scan(out).
+		Error)
+}
+
+// Players with the highest season total of the stat, stat must be one of models.StatColumns
+func (st *StatTable) FindLeaders(seasonID int, stat string, limit int, out *[]models.Leader) error {
+	total := fmt.Sprintf("SUM(stat_lines.%s)", stat)
+
+	return translate(st.
+		DB.
+		Table("stat_lines").
+		Select(fmt.Sprintf(
+			"RANK() OVER (ORDER BY %s DESC) AS rank, stat_lines.player_id, players.name AS player, COUNT(*) AS games, %s AS value",
+			total, total,
+		)).
+		Joins("JOIN games ON games.id = stat_lines.game_id").
+		Joins("JOIN players ON players.id = stat_lines.player_id").
+		Where("games.season_id = ?", seasonID).
+		Group("stat_lines.player_id, players.name").
+		Having(total + " > 0").
+		Order("rank ASC").
+		Order("stat_lines.player_id ASC").
+		Limit(limit).
+		Scan(out).
+		Error)
+}
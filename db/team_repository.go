@@ -4,22 +4,49 @@ import (
 	"github.com/jinzhu/gorm"
 	"github.com/logansua/nfl_app/models"
 	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
 )
 
+// Query parameters allowed for listing
+var TeamQueryFields = query.Fields{
+	"name":        {Column: "name", Type: query.String, Filter: query.Contains, Sortable: true},
+	"division_id": {Column: "division_id", Type: query.Int, Filter: query.Equal},
+	"division":    {Column: "(SELECT divisions.name FROM divisions WHERE divisions.id = teams.division_id)", Filter: query.Equal},
+	"conference":  {Column: "(SELECT conferences.name FROM divisions JOIN conferences ON conferences.id = divisions.conference_id WHERE divisions.id = teams.division_id)", Filter: query.Equal},
+	"id":          {Column: "id", Type: query.Int, Sortable: true},
+	"created_at":  {Column: "created_at", Sortable: true},
+	"updated_at":  {Column: "updated_at", Sortable: true},
+}
+
 type TeamRepository interface {
-	FindAllAndPaginate(paging pagination.Pagination, out *[]models.Team) error
+	FindAllAndPaginate(paging pagination.Pagination, q query.Query, out *[]models.Team, meta *pagination.Meta) error
+	FindWithPlayers(id int, out *models.Team) error
+	FindAllWithDivisions(out *[]models.Team) error
 }
 
 type TeamTable struct {
 	DB *gorm.DB
 }
 
-func (pt *TeamTable) FindAllAndPaginate(paging pagination.Pagination, out *[]models.Team) error {
-	return pt.
+func (pt *TeamTable) FindAllAndPaginate(paging pagination.Pagination, q query.Query, out *[]models.Team, meta *pagination.Meta) error {
+	return translate(findPage(pt.DB, paging, q, &models.Team{}, out, meta))
+}
+
+func (pt *TeamTable) FindWithPlayers(id int, out *models.Team) error {
+	return translate(pt.
 		DB.
-		Offset(paging.Offset).
-		Limit(paging.Limit).
-		Find(out).
+		Preload("Players", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id ASC")
+		}).
+		First(out, id).
+		Error)
+}
+
+func (pt *TeamTable) FindAllWithDivisions(out *[]models.Team) error {
+	return translate(pt.
+		DB.
+		Preload("Division.Conference").
 		Order("id ASC").
-		Error
+		Find(out).
+		Error)
 }
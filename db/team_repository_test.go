@@ -0,0 +1,25 @@
+package db
+
+import (
+	"github.com/logansua/nfl_app/query"
+	"github.com/stretchr/testify/assert"
+	"net/url"
+	"testing"
+)
+
+func TestTeamQueryFields_League(t *testing.T) {
+	q, err := query.New(url.Values{
+		"conference":  []string{"AFC"},
+		"division":    []string{"AFC East"},
+		"division_id": []string{"1"},
+	}, TeamQueryFields)
+
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []query.Filter{
+		{Column: TeamQueryFields["conference"].Column, Operator: query.Equal, Value: "AFC"},
+		{Column: TeamQueryFields["division"].Column, Operator: query.Equal, Value: "AFC East"},
+		{Column: "division_id", Operator: query.Equal, Value: 1},
+	}, q.Filters)
+	assert.Contains(t, TeamQueryFields["conference"].Column, "teams.division_id")
+	assert.Contains(t, TeamQueryFields["division"].Column, "teams.division_id")
+}
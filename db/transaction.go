@@ -0,0 +1,31 @@
+package db
+
+import "github.com/jinzhu/gorm"
+
+// Run function inside transaction, rollback if it returns an error
+func WithTransaction(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	tx := db.Begin()
+
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// Run read only function inside transaction with consistent snapshot of data
+func WithSnapshot(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	return WithTransaction(db, func(tx *gorm.DB) error {
+		if err := tx.Exec("SET TRANSACTION ISOLATION LEVEL REPEATABLE READ READ ONLY").Error; err != nil {
+			return err
+		}
+
+		return fn(tx)
+	})
+}
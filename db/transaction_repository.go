@@ -0,0 +1,202 @@
+package db
+
+import (
+	"github.com/jinzhu/gorm"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
+)
+
+// Query parameters allowed for listing
+var TransactionQueryFields = query.Fields{
+	"type":       {Column: "type", Type: query.String, Filter: query.Equal, Sortable: true},
+	"id":         {Column: "id", Type: query.Int, Sortable: true},
+	"created_at": {Column: "created_at", Sortable: true},
+}
+
+// ErrRosterChanged is returned when the player moved to another team since the transaction was checked
+var ErrRosterChanged = apperrors.New(apperrors.KindAlreadyExists, "player roster changed, retry the transaction")
+
+type TransactionRepository interface {
+	FindAllAndPaginate(paging pagination.Pagination, q query.Query, out *[]models.Transaction, meta *pagination.Meta) error
+	FindTeamTransactions(teamID int, paging pagination.Pagination, q query.Query, out *[]models.Transaction, meta *pagination.Meta) error
+	CreateTransaction(t *models.Transaction) error
+	CreateTrade(trade *models.Trade, check func(players []models.Player, picks []models.DraftPick) error) error
+	FindTrade(id int, out *models.Trade) error
+}
+
+type TransactionTable struct {
+	DB *gorm.DB
+}
+
+func (tt *TransactionTable) FindAllAndPaginate(paging pagination.Pagination, q query.Query, out *[]models.Transaction, meta *pagination.Meta) error {
+	return translate(findPage(tt.DB, paging, q, &models.Transaction{}, out, meta))
+}
+
+func (tt *TransactionTable) FindTeamTransactions(teamID int, paging pagination.Pagination, q query.Query, out *[]models.Transaction, meta *pagination.Meta) error {
+	teamTransactions := tt.DB.Where("from_team_id = ? OR to_team_id = ?", teamID, teamID)
+
+	return translate(findPage(teamTransactions, paging, q, &models.Transaction{}, out, meta))
+}
+
+// Move the player and record the transaction atomically
+func (tt *TransactionTable) CreateTransaction(t *models.Transaction) error {
+	return translate(WithTransaction(tt.DB, func(tx *gorm.DB) error {
+		return applyTransaction(tx, t)
+	}))
+}
+
+// Player row is locked and must still be on the team the transaction moves the player from
+func applyTransaction(tx *gorm.DB, t *models.Transaction) error {
+	var player models.Player
+
+	err := tx.
+		Set("gorm:query_option", "FOR UPDATE").
+		First(&player, t.PlayerID).
+		Error
+
+	if err != nil {
+		return err
+	}
+
+	if !models.SameID(player.TeamID, t.FromTeamID) {
+		return ErrRosterChanged
+	}
+
+	changes := map[string]interface{}{
+		"team_id": t.ToTeamID,
+		"status":  t.Status,
+	}
+
+	// Jersey number is assigned again by the new team
+	if !models.SameID(t.FromTeamID, t.ToTeamID) {
+		changes["jersey_number"] = nil
+	}
+
+	err = tx.
+		Model(&player).
+		Updates(changes).
+		Error
+
+	if err != nil {
+		return err
+	}
+
+	if err := moveContracts(tx, t); err != nil {
+		return err
+	}
+
+	return tx.Create(t).Error
+}
+
+// Lock all players and picks of the trade, check them and move them in a single transaction. Error
+// of the check is returned as is and nothing is written.
+func (tt *TransactionTable) CreateTrade(trade *models.Trade, check func(players []models.Player, picks []models.DraftPick) error) error {
+	var checkErr error
+
+	err := WithTransaction(tt.DB, func(tx *gorm.DB) error {
+		ids := make([]int, len(trade.Transactions))
+
+		for key, value := range trade.Transactions {
+			ids[key] = value.PlayerID
+		}
+
+		var players []models.Player
+
+		// Rows are locked in order of ids so concurrent trades can't deadlock
+		err := tx.
+			Set("gorm:query_option", "FOR UPDATE").
+			Where("id IN (?)", ids).
+			Order("id ASC").
+			Find(&players).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		pickIDs := make([]int, len(trade.PickTransfers))
+
+		for key, value := range trade.PickTransfers {
+			pickIDs[key] = value.DraftPickID
+		}
+
+		var picks []models.DraftPick
+
+		err = tx.
+			Set("gorm:query_option", "FOR UPDATE").
+			Where("id IN (?)", pickIDs).
+			Order("id ASC").
+			Find(&picks).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		if checkErr = check(players, picks); checkErr != nil {
+			return checkErr
+		}
+
+		err = tx.
+			Set("gorm:save_associations", false).
+			Create(trade).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		tradeID := int(trade.ID)
+
+		for key := range trade.Transactions {
+			t := &trade.Transactions[key]
+			t.TradeID = &tradeID
+
+			if err := applyTransaction(tx, t); err != nil {
+				return err
+			}
+		}
+
+		for key := range trade.PickTransfers {
+			transfer := &trade.PickTransfers[key]
+			transfer.TradeID = tradeID
+
+			err := tx.
+				Model(&models.DraftPick{}).
+				Where("id = ?", transfer.DraftPickID).
+				Update("owner_team_id", transfer.ToTeamID).
+				Error
+
+			if err != nil {
+				return err
+			}
+
+			if err := tx.Create(transfer).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if checkErr != nil {
+		return checkErr
+	}
+
+	return translate(err)
+}
+
+func (tt *TransactionTable) FindTrade(id int, out *models.Trade) error {
+	return translate(tt.
+		DB.
+		Preload("Transactions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id ASC")
+		}).
+		Preload("PickTransfers", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id ASC")
+		}).
+		First(out, id).
+		Error)
+}
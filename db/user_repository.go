@@ -0,0 +1,185 @@
+package db
+
+import (
+	"github.com/jinzhu/gorm"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/models"
+	"time"
+)
+
+// ErrTokenUsed is returned when the refresh token was already exchanged
+var ErrTokenUsed = apperrors.New(apperrors.KindUnauthenticated, "refresh token was already used")
+
+type UserRepository interface {
+	CreateUser(u *models.User, teamIDs []int) error
+	UpdateUserRole(email, role string, teamIDs []int, out *models.User) error
+	FindUserByEmail(email string, out *models.User) error
+	FindAPIKey(keyHash string, out *models.APIKey) error
+	TouchAPIKey(key *models.APIKey) error
+	FindUserAPIKeys(userID int, out *[]models.APIKey) error
+	DeleteAPIKey(userID, id int) error
+	RotateRefreshToken(tokenHash string, next *models.RefreshToken) error
+}
+
+type UserTable struct {
+	DB *gorm.DB
+}
+
+// Create user managing given teams, teams themselves are never saved
+func (ut *UserTable) CreateUser(u *models.User, teamIDs []int) error {
+	return translate(WithTransaction(ut.DB, func(tx *gorm.DB) error {
+		err := tx.
+			Set("gorm:save_associations", false).
+			Create(u).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		return replaceUserTeams(tx, u, teamIDs)
+	}))
+}
+
+// Change role and managed teams of the user
+func (ut *UserTable) UpdateUserRole(email, role string, teamIDs []int, out *models.User) error {
+	return translate(WithTransaction(ut.DB, func(tx *gorm.DB) error {
+		err := tx.
+			Set("gorm:query_option", "FOR UPDATE").
+			Where("LOWER(email) = LOWER(?)", email).
+			First(out).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		err = tx.
+			Model(out).
+			UpdateColumn("role", role).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		return replaceUserTeams(tx, out, teamIDs)
+	}))
+}
+
+func replaceUserTeams(tx *gorm.DB, u *models.User, teamIDs []int) error {
+	err := tx.Exec("DELETE FROM user_teams WHERE user_id = ?", u.ID).Error
+
+	if err != nil {
+		return err
+	}
+
+	for _, teamID := range teamIDs {
+		err = tx.Exec("INSERT INTO user_teams (user_id, team_id) VALUES (?, ?)", u.ID, teamID).Error
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Model(u).Related(&u.Teams, "Teams").Error
+}
+
+func (ut *UserTable) FindUserByEmail(email string, out *models.User) error {
+	return translate(ut.
+		DB.
+		Preload("Teams").
+		Where("LOWER(email) = LOWER(?)", email).
+		First(out).
+		Error)
+}
+
+// Find the key with its user
+func (ut *UserTable) FindAPIKey(keyHash string, out *models.APIKey) error {
+	return translate(ut.
+		DB.
+		Preload("User.Teams").
+		Where("key_hash = ?", keyHash).
+		First(out).
+		Error)
+}
+
+// Remember when the key was used
+func (ut *UserTable) TouchAPIKey(key *models.APIKey) error {
+	return translate(ut.
+		DB.
+		Model(key).
+		UpdateColumn("last_used_at", time.Now()).
+		Error)
+}
+
+func (ut *UserTable) FindUserAPIKeys(userID int, out *[]models.APIKey) error {
+	return translate(ut.
+		DB.
+		Where("user_id = ?", userID).
+		Order("id ASC").
+		Find(out).
+		Error)
+}
+
+// Users can delete only their own keys
+func (ut *UserTable) DeleteAPIKey(userID, id int) error {
+	result := ut.
+		DB.
+		Where("id = ? AND user_id = ?", id, userID).
+		Delete(&models.APIKey{})
+
+	if result.Error != nil {
+		return translate(result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return apperrors.ErrNotFound
+	}
+
+	return nil
+}
+
+// Revoke the refresh token and store the next one of the same user. Token is locked so it can be
+// exchanged only once, expired tokens are reported as not found.
+func (ut *UserTable) RotateRefreshToken(tokenHash string, next *models.RefreshToken) error {
+	return translate(WithTransaction(ut.DB, func(tx *gorm.DB) error {
+		var token models.RefreshToken
+
+		err := tx.
+			Set("gorm:query_option", "FOR UPDATE").
+			Where("token_hash = ? AND expires_at > ?", tokenHash, time.Now()).
+			First(&token).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		if token.RevokedAt != nil {
+			return ErrTokenUsed
+		}
+
+		err = tx.
+			Model(&token).
+			UpdateColumn("revoked_at", time.Now()).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		next.UserID = token.UserID
+
+		err = tx.Create(next).Error
+
+		if err != nil {
+			return err
+		}
+
+		return tx.
+			Preload("Teams").
+			First(&next.User, next.UserID).
+			Error
+	}))
+}
@@ -0,0 +1,23 @@
+package draft
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/logansua/nfl_app/auth"
+	"github.com/logansua/nfl_app/models/dto"
+)
+
+// Team managers may select players only with picks their teams own
+func authorizeSelection(s Service) endpoint.Middleware {
+	return auth.ManageTeams(func(ctx context.Context, request interface{}) ([]*int, error) {
+		req := request.(makeSelectionRequest)
+
+		var pick dto.DraftPickDTO
+
+		if err := s.GetPick(ctx, req.year, req.Selection.Overall, &pick); err != nil {
+			return nil, err
+		}
+
+		return []*int{&pick.OwnerTeamID}, nil
+	})
+}
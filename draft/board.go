@@ -0,0 +1,90 @@
+package draft
+
+import (
+	"github.com/logansua/nfl_app/models/dto"
+	"sync"
+)
+
+// Picks buffered for each client, slow clients miss picks and should reload the board
+const subscriberBuffer = 16
+
+// Board fans out picks made in live drafts to the connected clients
+type Board struct {
+	mu          sync.Mutex
+	subscribers map[int]map[chan dto.DraftPickDTO]bool
+	closed      bool
+}
+
+func NewBoard() *Board {
+	return &Board{subscribers: make(map[int]map[chan dto.DraftPickDTO]bool)}
+}
+
+// Subscribe to picks of the season, returned func cancels the subscription and closes the channel
+func (b *Board) Subscribe(year int) (<-chan dto.DraftPickDTO, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := make(chan dto.DraftPickDTO, subscriberBuffer)
+
+	if b.closed {
+		close(c)
+
+		return c, func() {}
+	}
+
+	if b.subscribers[year] == nil {
+		b.subscribers[year] = make(map[chan dto.DraftPickDTO]bool)
+	}
+
+	b.subscribers[year][c] = true
+
+	var once sync.Once
+
+	return c, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			// Channel was already closed by Close
+			if !b.subscribers[year][c] {
+				return
+			}
+
+			delete(b.subscribers[year], c)
+
+			if len(b.subscribers[year]) == 0 {
+				delete(b.subscribers, year)
+			}
+
+			close(c)
+		})
+	}
+}
+
+// Send the pick to all subscribers of the season without waiting for them
+func (b *Board) Publish(year int, pick dto.DraftPickDTO) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for c := range b.subscribers[year] {
+		select {
+		case c <- pick:
+		default:
+		}
+	}
+}
+
+// Close channels of all subscribers and refuse new ones, so streams end when the server shuts down
+func (b *Board) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, subscribers := range b.subscribers {
+		for c := range subscribers {
+			close(c)
+		}
+	}
+
+	b.subscribers = make(map[int]map[chan dto.DraftPickDTO]bool)
+	b.closed = true
+}
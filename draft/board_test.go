@@ -0,0 +1,56 @@
+package draft
+
+import (
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBoard(t *testing.T) {
+	board := NewBoard()
+
+	picks, cancel := board.Subscribe(2019)
+	other, cancelOther := board.Subscribe(2020)
+
+	defer cancelOther()
+
+	board.Publish(2019, dto.DraftPickDTO{Season: 2019, Overall: 1})
+
+	assert.Equal(t, 1, (<-picks).Overall)
+	assert.Empty(t, other)
+
+	cancel()
+	cancel()
+
+	_, open := <-picks
+
+	assert.False(t, open)
+
+	// Slow subscribers don't block the draft
+	for i := 0; i < subscriberBuffer+1; i++ {
+		board.Publish(2020, dto.DraftPickDTO{Season: 2020, Overall: i + 1})
+	}
+
+	assert.Len(t, other, subscriberBuffer)
+}
+
+func TestBoard_Close(t *testing.T) {
+	board := NewBoard()
+
+	picks, cancel := board.Subscribe(2019)
+
+	board.Close()
+
+	_, open := <-picks
+
+	assert.False(t, open)
+
+	// Cancelling after close doesn't close the channel again
+	cancel()
+
+	late, _ := board.Subscribe(2019)
+
+	_, open = <-late
+
+	assert.False(t, open)
+}
@@ -0,0 +1,125 @@
+package draft
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/logansua/nfl_app/auth"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/utils"
+	"github.com/logansua/nfl_app/validation"
+)
+
+type Endpoints struct {
+	CreateDraftEndpoint   endpoint.Endpoint
+	GetDraftBoardEndpoint endpoint.Endpoint
+	MakeSelectionEndpoint endpoint.Endpoint
+}
+
+func MakeServerEndpoints(s Service, authenticate endpoint.Middleware) Endpoints {
+	validate := validation.Middleware()
+	admin := auth.RequireRole(models.RoleAdmin)
+
+	return Endpoints{
+		CreateDraftEndpoint:   authenticate(admin(validate(MakeCreateDraftEndpoint(s)))),
+		GetDraftBoardEndpoint: authenticate(MakeGetDraftBoardEndpoint(s)),
+		MakeSelectionEndpoint: authenticate(authorizeSelection(s)(validate(MakeMakeSelectionEndpoint(s)))),
+	}
+}
+
+func (e Endpoints) CreateDraft(ctx context.Context, year int, order dto.DraftOrderDTO) error {
+	request := createDraftRequest{year: year, Order: order}
+	response, err := e.CreateDraftEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetDraftBoard(ctx context.Context, year int) error {
+	request := yearRequest{year: year}
+	response, err := e.GetDraftBoardEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) MakeSelection(ctx context.Context, year int, selection dto.DraftSelectionDTO) error {
+	request := makeSelectionRequest{year: year, Selection: selection}
+	response, err := e.MakeSelectionEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+
+func MakeCreateDraftEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(createDraftRequest)
+
+		var picks []dto.DraftPickDTO
+
+		err = service.CreateDraft(ctx, req.year, req.Order, &picks)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: picks}, nil
+	}
+}
+func MakeGetDraftBoardEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(yearRequest)
+
+		var picks []dto.DraftPickDTO
+
+		err = service.GetDraftBoard(ctx, req.year, &picks)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: picks}, nil
+	}
+}
+func MakeMakeSelectionEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(makeSelectionRequest)
+
+		var pick dto.DraftPickDTO
+
+		err = service.MakeSelection(ctx, req.year, req.Selection, &pick)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: pick}, nil
+	}
+}
+
+type createDraftRequest struct {
+	year  int
+	Order dto.DraftOrderDTO
+}
+
+type yearRequest struct {
+	year int
+}
+
+type makeSelectionRequest struct {
+	year      int
+	Selection dto.DraftSelectionDTO
+}
@@ -0,0 +1,217 @@
+package draft
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/router"
+	"github.com/logansua/nfl_app/validation"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func GetServiceOptions(logger log.Logger) []httptransport.ServerOption {
+	return router.ServerOptions(logger)
+}
+
+func CreateRoutes(s Service, logger log.Logger, authenticate endpoint.Middleware) []router.Route {
+	endpoints := MakeServerEndpoints(s, authenticate)
+
+	options := GetServiceOptions(logger)
+
+	return []router.Route{
+		{
+			Name:        "Create draft",
+			Method:      http.MethodPost,
+			Path:        "/seasons/{year}/draft",
+			StrictSlash: false,
+			Handler: httptransport.NewServer(
+				endpoints.CreateDraftEndpoint,
+				decodeCreateDraftRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get draft board",
+			Method:      http.MethodGet,
+			Path:        "/seasons/{year}/draft",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetDraftBoardEndpoint,
+				decodeYearRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Make draft selection",
+			Method:      http.MethodPost,
+			Path:        "/seasons/{year}/draft/selections",
+			StrictSlash: false,
+			Handler: httptransport.NewServer(
+				endpoints.MakeSelectionEndpoint,
+				decodeMakeSelectionRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Follow live draft",
+			Method:      http.MethodGet,
+			Path:        "/seasons/{year}/draft/live",
+			StrictSlash: true,
+			Handler:     liveHandler(s, logger, authenticate),
+		},
+	}
+}
+
+func decodeCreateDraftRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req createDraftRequest
+
+	year, err := strconv.Atoi(mux.Vars(r)["year"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	if e := validation.DecodeJSON(r.Body, &req.Order); e != nil {
+		return nil, e
+	}
+
+	req.year = year
+
+	return req, nil
+}
+func decodeYearRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req yearRequest
+
+	year, err := strconv.Atoi(mux.Vars(r)["year"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.year = year
+
+	return req, nil
+}
+func decodeMakeSelectionRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req makeSelectionRequest
+
+	year, err := strconv.Atoi(mux.Vars(r)["year"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	if e := validation.DecodeJSON(r.Body, &req.Selection); e != nil {
+		return nil, e
+	}
+
+	if req.Selection.Player.Status == "" {
+		req.Selection.Player.Status = models.PlayerActive
+	}
+
+	req.year = year
+
+	return req, nil
+}
+
+// Stream of server-sent events, the board is sent first and then every pick as it is made. The stream
+// isn't a go-kit server, so the caller is checked by running the middleware around a no-op endpoint.
+func liveHandler(s Service, logger log.Logger, authenticate endpoint.Middleware) http.Handler {
+	authorize := authenticate(func(context.Context, interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		if _, err := authorize(httptransport.PopulateRequestContext(ctx, r), nil); err != nil {
+			router.EncodeError(ctx, err, w)
+
+			return
+		}
+
+		year, err := strconv.Atoi(mux.Vars(r)["year"])
+
+		if err != nil {
+			router.EncodeError(ctx, err, w)
+
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+
+		if !ok {
+			router.EncodeError(ctx, fmt.Errorf("streaming is not supported"), w)
+
+			return
+		}
+
+		// Subscribe before reading the board so no pick is missed in between
+		picks, cancel := s.Subscribe(year)
+		defer cancel()
+
+		var board []dto.DraftPickDTO
+
+		if err := s.GetDraftBoard(ctx, year, &board); err != nil {
+			router.EncodeError(ctx, err, w)
+
+			return
+		}
+
+		// The stream outlives the write timeout of the server
+		if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+			logger.Log("METHOD", r.Method, "PATH", r.URL.Path, "err", err)
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+
+		if err := writeEvent(w, "board", board); err != nil {
+			return
+		}
+
+		flusher.Flush()
+
+		logger.Log("METHOD", r.Method, "PATH", r.URL.Path, "CODE", http.StatusOK)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case pick, ok := <-picks:
+				if !ok {
+					return
+				}
+
+				if err := writeEvent(w, "pick", pick); err != nil {
+					return
+				}
+
+				flusher.Flush()
+			}
+		}
+	})
+}
+
+func writeEvent(w http.ResponseWriter, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+
+	return err
+}
@@ -0,0 +1,172 @@
+package draft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/logansua/nfl_app/db"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+)
+
+// Service runs the draft of the season, picks are traded by the transaction service.
+type Service interface {
+	// Create picks of all rounds in the order of teams
+	CreateDraft(ctx context.Context, year int, order dto.DraftOrderDTO, picks *[]dto.DraftPickDTO) error
+	// Get all picks of the season with selected players
+	GetDraftBoard(ctx context.Context, year int, picks *[]dto.DraftPickDTO) error
+	// Make the pick on the clock, the player is created on the team owning the pick
+	MakeSelection(ctx context.Context, year int, selection dto.DraftSelectionDTO, pick *dto.DraftPickDTO) error
+	// Get pick of the season by overall number
+	GetPick(ctx context.Context, year, overall int, pick *dto.DraftPickDTO) error
+	// Follow picks made in the draft of the season, returned func cancels the subscription
+	Subscribe(year int) (<-chan dto.DraftPickDTO, func())
+	// End all subscriptions, used when the server shuts down
+	Close()
+}
+
+type service struct {
+	DB    *db.DB
+	Board *Board
+}
+
+func New(dbService *db.DB) Service {
+	return &service{DB: dbService, Board: NewBoard()}
+}
+
+func (s *service) CreateDraft(ctx context.Context, year int, order dto.DraftOrderDTO, picks *[]dto.DraftPickDTO) error {
+	err := s.checkTeams(order.TeamIDs)
+
+	if err != nil {
+		return err
+	}
+
+	var season models.Season
+
+	err = s.DB.GameRepository.FindOrCreateSeason(year, &season)
+
+	if err != nil {
+		return err
+	}
+
+	err = s.DB.DraftRepository.CreateDraft(int(season.ID), models.NewDraftPickModels(int(season.ID), &order))
+
+	if err != nil {
+		return err
+	}
+
+	return s.GetDraftBoard(ctx, year, picks)
+}
+
+// Referential check of the teams in draft order
+func (s *service) checkTeams(ids []int) error {
+	var teams []models.Team
+
+	err := s.DB.Repository.FindByIds(&teams, ids)
+
+	if err != nil {
+		return err
+	}
+
+	found := make(map[int]bool, len(teams))
+
+	for _, value := range teams {
+		found[int(value.ID)] = true
+	}
+
+	errs := validation.New()
+
+	for key, id := range ids {
+		errs.Check(found[id], fmt.Sprintf("team_ids[%d]", key), validation.CodeNotFound)
+	}
+
+	return errs.Err()
+}
+
+func (s *service) GetDraftBoard(ctx context.Context, year int, picks *[]dto.DraftPickDTO) error {
+	var season models.Season
+
+	err := s.DB.GameRepository.FindSeasonByYear(year, &season)
+
+	if err != nil {
+		return err
+	}
+
+	var p []models.DraftPick
+
+	err = s.DB.DraftRepository.FindSeasonDraft(int(season.ID), &p)
+
+	*picks = make([]dto.DraftPickDTO, len(p))
+
+	for key, value := range p {
+		(*picks)[key] = models.NewDraftPickDTO(value)
+	}
+
+	return err
+}
+
+func (s *service) MakeSelection(ctx context.Context, year int, selection dto.DraftSelectionDTO, pick *dto.DraftPickDTO) error {
+	var p models.DraftPick
+
+	err := s.findPick(year, selection.Overall, &p)
+
+	if err != nil {
+		return err
+	}
+
+	player := models.NewPlayerModel(&selection.Player)
+
+	err = s.DB.DraftRepository.SelectPlayer(&p, &player)
+
+	if err != nil {
+		return err
+	}
+
+	*pick = models.NewDraftPickDTO(p)
+
+	s.Board.Publish(year, *pick)
+
+	return nil
+}
+
+func (s *service) GetPick(ctx context.Context, year, overall int, pick *dto.DraftPickDTO) error {
+	var p models.DraftPick
+
+	err := s.findPick(year, overall, &p)
+
+	if err != nil {
+		return err
+	}
+
+	*pick = models.NewDraftPickDTO(p)
+
+	return nil
+}
+
+func (s *service) findPick(year, overall int, pick *models.DraftPick) error {
+	var season models.Season
+
+	err := s.DB.GameRepository.FindSeasonByYear(year, &season)
+
+	if err != nil {
+		return err
+	}
+
+	err = s.DB.DraftRepository.FindPick(int(season.ID), overall, pick)
+
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return validation.Errors{{Field: "overall", Code: validation.CodeNotFound}}
+	}
+
+	return err
+}
+
+func (s *service) Subscribe(year int) (<-chan dto.DraftPickDTO, func()) {
+	return s.Board.Subscribe(year)
+}
+
+func (s *service) Close() {
+	s.Board.Close()
+}
@@ -0,0 +1,49 @@
+package draft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/logansua/nfl_app/player"
+	"github.com/logansua/nfl_app/validation"
+)
+
+const maxRounds = 10
+
+func (r createDraftRequest) Validate(_ context.Context) error {
+	errs := validation.New().
+		Range("rounds", r.Order.Rounds, 1, maxRounds).
+		Check(len(r.Order.TeamIDs) > 0, "team_ids", validation.CodeRequired)
+
+	teams := make(map[int]bool, len(r.Order.TeamIDs))
+
+	for key, id := range r.Order.TeamIDs {
+		field := fmt.Sprintf("team_ids[%d]", key)
+
+		errs.
+			ID(field, id).
+			Check(!teams[id], field, validation.CodeDuplicate)
+
+		teams[id] = true
+	}
+
+	return errs.Err()
+}
+
+func (r makeSelectionRequest) Validate(_ context.Context) error {
+	errs := validation.New().
+		ID("overall", r.Selection.Overall)
+
+	// Team of the player is the owner of the pick
+	errs.Check(r.Selection.Player.TeamID == nil, "player.team_id", validation.CodeInvalid)
+
+	var fields validation.Errors
+
+	if err := player.ValidatePlayer(r.Selection.Player); errors.As(err, &fields) {
+		for _, value := range fields {
+			errs.Add("player."+value.Field, value.Code)
+		}
+	}
+
+	return errs.Err()
+}
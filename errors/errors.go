@@ -1,9 +1,105 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"net/http"
+)
+
+// Kind classifies application errors, every kind is mapped to HTTP status code
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindNotFound
+	KindAlreadyExists
+	KindInvalidArgument
+	KindValidation
+	KindTooLarge
+	KindUnavailable
+	KindUnauthenticated
+	KindForbidden
+	KindRateLimited
+)
+
+// Error is an application error, errors of the same kind match each other with errors.Is
+type Error struct {
+	Kind    Kind
+	Message string
+	Details interface{}
+	Err     error
+}
 
 var (
-	ErrInconsistentIDs = errors.New("inconsistent IDs")
-	ErrAlreadyExists   = errors.New("already exists")
-	ErrNotFound        = errors.New("not found")
+	ErrInconsistentIDs = New(KindInvalidArgument, "inconsistent IDs")
+	ErrAlreadyExists   = New(KindAlreadyExists, "already exists")
+	ErrNotFound        = New(KindNotFound, "not found")
+	ErrInvalidArgument = New(KindInvalidArgument, "invalid argument")
+	ErrInvalidCursor   = New(KindInvalidArgument, "invalid cursor")
+	ErrInternal        = New(KindInternal, "internal error")
+	ErrUnauthenticated = New(KindUnauthenticated, "authentication required")
+	ErrForbidden       = New(KindForbidden, "not allowed")
+	ErrRateLimited     = New(KindRateLimited, "too many requests")
 )
+
+func New(kind Kind, message string) *Error {
+	return &Error{Kind: kind, Message: message}
+}
+
+// Wrap cause into application error of given kind
+func Wrap(kind Kind, message string, err error) *Error {
+	return &Error{Kind: kind, Message: message, Err: err}
+}
+
+// Convert any error into application error, unknown errors are internal
+func From(err error) *Error {
+	var e *Error
+
+	if errors.As(err, &e) {
+		return e
+	}
+
+	return Wrap(KindInternal, ErrInternal.Message, err)
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+
+	return ok && t.Kind == e.Kind
+}
+
+func (k Kind) Status() int {
+	switch k {
+	case KindNotFound:
+		return http.StatusNotFound
+	case KindAlreadyExists:
+		return http.StatusConflict
+	case KindInvalidArgument:
+		return http.StatusBadRequest
+	case KindValidation:
+		return http.StatusUnprocessableEntity
+	case KindTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case KindUnavailable:
+		return http.StatusServiceUnavailable
+	case KindUnauthenticated:
+		return http.StatusUnauthorized
+	case KindForbidden:
+		return http.StatusForbidden
+	case KindRateLimited:
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,28 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestError_Is(t *testing.T) {
+	cause := errors.New("record not found")
+	err := fmt.Errorf("get player: %w", Wrap(KindNotFound, "player not found", cause))
+
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.True(t, errors.Is(err, cause))
+	assert.False(t, errors.Is(err, ErrAlreadyExists))
+}
+
+func TestFrom(t *testing.T) {
+	assert.Equal(t, http.StatusNotFound, From(fmt.Errorf("wrapped: %w", ErrNotFound)).Kind.Status())
+
+	e := From(errors.New("connection refused"))
+
+	assert.Equal(t, KindInternal, e.Kind)
+	assert.Equal(t, ErrInternal.Message, e.Message)
+	assert.Equal(t, http.StatusInternalServerError, e.Kind.Status())
+}
@@ -0,0 +1,198 @@
+package game
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/logansua/nfl_app/auth"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
+	"github.com/logansua/nfl_app/utils"
+	"github.com/logansua/nfl_app/validation"
+)
+
+type Endpoints struct {
+	CreateGameEndpoint        endpoint.Endpoint
+	GetGameEndpoint           endpoint.Endpoint
+	RecordResultEndpoint      endpoint.Endpoint
+	GetSeasonScheduleEndpoint endpoint.Endpoint
+	GetTeamGamesEndpoint      endpoint.Endpoint
+}
+
+func MakeServerEndpoints(s Service, authenticate endpoint.Middleware) Endpoints {
+	validate := validation.Middleware()
+	admin := auth.RequireRole(models.RoleAdmin)
+
+	return Endpoints{
+		CreateGameEndpoint:        authenticate(admin(validate(MakeCreateGameEndpoint(s)))),
+		GetGameEndpoint:           authenticate(MakeGetGameEndpoint(s)),
+		RecordResultEndpoint:      authenticate(admin(validate(MakeRecordResultEndpoint(s)))),
+		GetSeasonScheduleEndpoint: authenticate(MakeGetSeasonScheduleEndpoint(s)),
+		GetTeamGamesEndpoint:      authenticate(MakeGetTeamGamesEndpoint(s)),
+	}
+}
+
+func (e Endpoints) CreateGame(ctx context.Context, g dto.GameDTO) error {
+	request := createGameRequest{Game: g}
+	response, err := e.CreateGameEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetGame(ctx context.Context, id int) error {
+	request := gameIdRequest{id: id}
+	response, err := e.GetGameEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) RecordResult(ctx context.Context, id int, result dto.GameResultDTO) error {
+	request := recordResultRequest{id: id, Result: result}
+	response, err := e.RecordResultEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetSeasonSchedule(ctx context.Context, year int, paging pagination.Pagination, q query.Query) error {
+	request := getSeasonScheduleRequest{year: year, Paging: paging, Query: q}
+	response, err := e.GetSeasonScheduleEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.PaginatedResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetTeamGames(ctx context.Context, teamID int, paging pagination.Pagination, q query.Query) error {
+	request := getTeamGamesRequest{teamID: teamID, Paging: paging, Query: q}
+	response, err := e.GetTeamGamesEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.PaginatedResponse)
+
+	return resp.Err
+}
+
+func MakeCreateGameEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(createGameRequest)
+
+		g := req.Game
+
+		err = service.CreateGame(ctx, &g)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: g}, nil
+	}
+}
+func MakeGetGameEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(gameIdRequest)
+
+		var game dto.GameDTO
+
+		err = service.GetGame(ctx, req.id, &game)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: game}, nil
+	}
+}
+func MakeRecordResultEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(recordResultRequest)
+
+		var game dto.GameDTO
+
+		err = service.RecordResult(ctx, req.id, req.Result, &game)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: game}, nil
+	}
+}
+func MakeGetSeasonScheduleEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getSeasonScheduleRequest)
+
+		var games []dto.GameDTO
+		var meta pagination.Meta
+
+		err = service.GetSeasonSchedule(ctx, req.year, req.Paging, req.Query, &games, &meta)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.PaginatedResponse{Data: games, Meta: meta}, nil
+	}
+}
+func MakeGetTeamGamesEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getTeamGamesRequest)
+
+		var games []dto.GameDTO
+		var meta pagination.Meta
+
+		err = service.GetTeamGames(ctx, req.teamID, req.Paging, req.Query, &games, &meta)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.PaginatedResponse{Data: games, Meta: meta}, nil
+	}
+}
+
+type createGameRequest struct {
+	Game dto.GameDTO
+}
+
+type gameIdRequest struct {
+	id int
+}
+
+type recordResultRequest struct {
+	id     int
+	Result dto.GameResultDTO
+}
+
+type getSeasonScheduleRequest struct {
+	year   int
+	Paging pagination.Pagination
+	Query  query.Query
+}
+
+type getTeamGamesRequest struct {
+	teamID int
+	Paging pagination.Pagination
+	Query  query.Query
+}
@@ -0,0 +1,194 @@
+package game
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/logansua/nfl_app/db"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
+	"github.com/logansua/nfl_app/router"
+	"github.com/logansua/nfl_app/validation"
+	"net/http"
+	"strconv"
+)
+
+func GetServiceOptions(logger log.Logger) []httptransport.ServerOption {
+	return router.ServerOptions(logger)
+}
+
+func CreateRoutes(s Service, logger log.Logger, authenticate endpoint.Middleware) []router.Route {
+	endpoints := MakeServerEndpoints(s, authenticate)
+
+	options := GetServiceOptions(logger)
+
+	return []router.Route{
+		{
+			Name:        "Create game",
+			Method:      http.MethodPost,
+			Path:        "/games",
+			StrictSlash: false,
+			Handler: httptransport.NewServer(
+				endpoints.CreateGameEndpoint,
+				decodeCreateGameRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get game",
+			Method:      http.MethodGet,
+			Path:        "/games/{id}",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetGameEndpoint,
+				decodeGetGameRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Record game result",
+			Method:      http.MethodPut,
+			Path:        "/games/{id}/result",
+			StrictSlash: false,
+			Handler: httptransport.NewServer(
+				endpoints.RecordResultEndpoint,
+				decodeRecordResultRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get season schedule",
+			Method:      http.MethodGet,
+			Path:        "/seasons/{year}/schedule",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetSeasonScheduleEndpoint,
+				decodeGetSeasonScheduleRequest,
+				router.EncodePaginatedResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get team games",
+			Method:      http.MethodGet,
+			Path:        "/teams/{id}/games",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetTeamGamesEndpoint,
+				decodeGetTeamGamesRequest,
+				router.EncodePaginatedResponse,
+				options...,
+			),
+		},
+	}
+}
+
+func decodeCreateGameRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req createGameRequest
+
+	if e := validation.DecodeJSON(r.Body, &req.Game); e != nil {
+		return nil, e
+	}
+
+	if req.Game.Status == "" {
+		req.Game.Status = models.GameScheduled
+	}
+
+	return req, nil
+}
+func decodeGetGameRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req gameIdRequest
+
+	params := mux.Vars(r)
+
+	id, err := strconv.Atoi(params["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.id = id
+
+	return req, nil
+}
+func decodeRecordResultRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req recordResultRequest
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	if e := validation.DecodeJSON(r.Body, &req.Result); e != nil {
+		return nil, e
+	}
+
+	req.id = id
+
+	return req, nil
+}
+func decodeGetSeasonScheduleRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req getSeasonScheduleRequest
+
+	year, err := strconv.Atoi(mux.Vars(r)["year"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	params := r.URL.Query()
+
+	paging, err := pagination.Parse(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := query.New(params, db.GameQueryFields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.year = year
+	req.Paging = paging
+	req.Query = q
+
+	return req, nil
+}
+func decodeGetTeamGamesRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req getTeamGamesRequest
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	params := r.URL.Query()
+
+	paging, err := pagination.Parse(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := query.New(params, db.GameQueryFields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.teamID = id
+	req.Paging = paging
+	req.Query = q
+
+	return req, nil
+}
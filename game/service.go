@@ -0,0 +1,176 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"github.com/logansua/nfl_app/db"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
+	"github.com/logansua/nfl_app/team"
+	"github.com/logansua/nfl_app/validation"
+)
+
+// Service manages season schedules.
+type Service interface {
+	// Create game
+	CreateGame(ctx context.Context, game *dto.GameDTO) error
+	// Get single game by ID
+	GetGame(ctx context.Context, id int, game *dto.GameDTO) error
+	// Record score and status of the game
+	RecordResult(ctx context.Context, id int, result dto.GameResultDTO, game *dto.GameDTO) error
+	// Get games of the season
+	GetSeasonSchedule(ctx context.Context, year int, paging pagination.Pagination, q query.Query, games *[]dto.GameDTO, meta *pagination.Meta) error
+	// Get games of the team
+	GetTeamGames(ctx context.Context, teamID int, paging pagination.Pagination, q query.Query, games *[]dto.GameDTO, meta *pagination.Meta) error
+}
+
+type service struct {
+	DB          *db.DB
+	TeamService team.Service
+}
+
+func New(dbService *db.DB, teamService team.Service) Service {
+	return &service{
+		DB:          dbService,
+		TeamService: teamService,
+	}
+}
+
+func (s *service) CreateGame(ctx context.Context, game *dto.GameDTO) error {
+	g := models.NewGameModel(game)
+
+	err := s.checkTeams(ctx, g.HomeTeamID, g.AwayTeamID)
+
+	if err != nil {
+		return err
+	}
+
+	err = s.DB.GameRepository.FindOrCreateSeason(game.Season, &g.Season)
+
+	if err != nil {
+		return err
+	}
+
+	g.SeasonID = int(g.Season.ID)
+
+	err = s.DB.GameRepository.CreateGame(&g)
+
+	if err != nil {
+		return err
+	}
+
+	*game = models.NewGameDTO(g)
+
+	return nil
+}
+
+func (s *service) GetGame(ctx context.Context, id int, game *dto.GameDTO) error {
+	var g models.Game
+
+	err := s.DB.GameRepository.FindGame(id, &g)
+
+	*game = models.NewGameDTO(g)
+
+	return err
+}
+
+func (s *service) RecordResult(ctx context.Context, id int, result dto.GameResultDTO, game *dto.GameDTO) error {
+	var g models.Game
+
+	err := s.DB.GameRepository.FindGame(id, &g)
+
+	if err != nil {
+		return err
+	}
+
+	g.Status = result.Status
+	g.HomeScore = result.HomeScore
+	g.AwayScore = result.AwayScore
+
+	err = s.DB.GameRepository.UpdateResult(&g)
+
+	*game = models.NewGameDTO(g)
+
+	return err
+}
+
+func (s *service) GetSeasonSchedule(ctx context.Context, year int, paging pagination.Pagination, q query.Query, games *[]dto.GameDTO, meta *pagination.Meta) error {
+	var season models.Season
+
+	err := s.DB.GameRepository.FindSeasonByYear(year, &season)
+
+	if err != nil {
+		return err
+	}
+
+	q.Filters = append(q.Filters, query.Filter{Column: "season_id", Operator: query.Equal, Value: season.ID})
+
+	return s.findGames(q, games, func(q query.Query, out *[]models.Game) error {
+		return s.DB.GameRepository.FindAllAndPaginate(paging, q, out, meta)
+	})
+}
+
+func (s *service) GetTeamGames(ctx context.Context, teamID int, paging pagination.Pagination, q query.Query, games *[]dto.GameDTO, meta *pagination.Meta) error {
+	var teamDTO dto.TeamDTO
+
+	err := s.TeamService.GetTeam(ctx, teamID, &teamDTO)
+
+	if err != nil {
+		return err
+	}
+
+	return s.findGames(q, games, func(q query.Query, out *[]models.Game) error {
+		return s.DB.GameRepository.FindTeamGames(teamID, paging, q, out, meta)
+	})
+}
+
+// Games are listed in order of kickoff unless other sorting is requested
+func (s *service) findGames(q query.Query, games *[]dto.GameDTO, find func(q query.Query, out *[]models.Game) error) error {
+	if len(q.Sort) == 0 {
+		q.Sort = []query.Sort{{Column: "kickoff_at"}}
+	}
+
+	q.Preload = append(q.Preload, "Season")
+
+	var g []models.Game
+
+	err := find(q, &g)
+
+	*games = make([]dto.GameDTO, len(g))
+
+	for key, value := range g {
+		(*games)[key] = models.NewGameDTO(value)
+	}
+
+	return err
+}
+
+// Referential check of both teams
+func (s *service) checkTeams(ctx context.Context, homeTeamID, awayTeamID int) error {
+	errs := validation.New()
+
+	teams := []struct {
+		field string
+		id    int
+	}{
+		{"home_team_id", homeTeamID},
+		{"away_team_id", awayTeamID},
+	}
+
+	for _, t := range teams {
+		var teamDTO dto.TeamDTO
+
+		err := s.TeamService.GetTeam(ctx, t.id, &teamDTO)
+
+		if errors.Is(err, apperrors.ErrNotFound) {
+			errs.Add(t.field, validation.CodeNotFound)
+		} else if err != nil {
+			return err
+		}
+	}
+
+	return errs.Err()
+}
@@ -0,0 +1,46 @@
+package game
+
+import (
+	"context"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+)
+
+const (
+	maxLength = 255
+	minSeason = 1920
+	maxSeason = 2100
+	// Regular season and playoffs
+	maxWeek = 22
+)
+
+// Code of a game where team plays itself
+const CodeSameTeam = "same_team"
+
+func validateGame(game dto.GameDTO) error {
+	return validation.New().
+		Range("season", game.Season, minSeason, maxSeason).
+		Range("week", game.Week, 1, maxWeek).
+		ID("home_team_id", game.HomeTeamID).
+		ID("away_team_id", game.AwayTeamID).
+		Check(game.HomeTeamID == 0 || game.HomeTeamID != game.AwayTeamID, "away_team_id", CodeSameTeam).
+		Check(!game.KickoffAt.IsZero(), "kickoff_at", validation.CodeRequired).
+		MaxLength("venue", game.Venue, maxLength).
+		OneOf("status", game.Status, models.GameStatuses...).
+		Check(game.HomeScore >= 0, "home_score", validation.CodeMin).
+		Check(game.AwayScore >= 0, "away_score", validation.CodeMin).
+		Err()
+}
+
+func (r createGameRequest) Validate(_ context.Context) error {
+	return validateGame(r.Game)
+}
+
+func (r recordResultRequest) Validate(_ context.Context) error {
+	return validation.New().
+		OneOf("status", r.Result.Status, models.GameStatuses...).
+		Check(r.Result.HomeScore >= 0, "home_score", validation.CodeMin).
+		Check(r.Result.AwayScore >= 0, "away_score", validation.CodeMin).
+		Err()
+}
@@ -0,0 +1,31 @@
+package game
+
+import (
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestValidateGame(t *testing.T) {
+	game := dto.GameDTO{
+		Season:     2018,
+		Week:       1,
+		HomeTeamID: 1,
+		AwayTeamID: 2,
+		KickoffAt:  time.Date(2018, 9, 6, 20, 20, 0, 0, time.UTC),
+		Status:     models.GameScheduled,
+	}
+
+	assert.Nil(t, validateGame(game))
+
+	game.AwayTeamID = game.HomeTeamID
+	game.Week = 0
+
+	assert.Equal(t, validation.Errors{
+		{Field: "week", Code: validation.CodeMin},
+		{Field: "away_team_id", Code: CodeSameTeam},
+	}, validateGame(game))
+}
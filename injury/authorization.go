@@ -0,0 +1,36 @@
+package injury
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/logansua/nfl_app/auth"
+	"github.com/logansua/nfl_app/models/dto"
+)
+
+// Team managers report injuries of players on their teams and update injuries reported on them
+func authorizeInjury(s Service) endpoint.Middleware {
+	return auth.ManageTeams(func(ctx context.Context, request interface{}) ([]*int, error) {
+		var teamID *int
+
+		switch req := request.(type) {
+		case reportInjuryRequest:
+			var p dto.PlayerDTO
+
+			if err := s.GetPlayer(ctx, req.playerID, &p); err != nil {
+				return nil, err
+			}
+
+			teamID = p.TeamID
+		case updateInjuryRequest:
+			var i dto.InjuryDTO
+
+			if err := s.GetInjury(ctx, req.id, &i); err != nil {
+				return nil, err
+			}
+
+			teamID = i.TeamID
+		}
+
+		return []*int{teamID}, nil
+	})
+}
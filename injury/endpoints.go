@@ -0,0 +1,163 @@
+package injury
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
+	"github.com/logansua/nfl_app/utils"
+	"github.com/logansua/nfl_app/validation"
+)
+
+type Endpoints struct {
+	ReportInjuryEndpoint    endpoint.Endpoint
+	UpdateInjuryEndpoint    endpoint.Endpoint
+	GetTeamInjuriesEndpoint endpoint.Endpoint
+	GetInjuryReportEndpoint endpoint.Endpoint
+}
+
+func MakeServerEndpoints(s Service, authenticate endpoint.Middleware) Endpoints {
+	validate := validation.Middleware()
+	manager := authorizeInjury(s)
+
+	return Endpoints{
+		ReportInjuryEndpoint:    authenticate(manager(validate(MakeReportInjuryEndpoint(s)))),
+		UpdateInjuryEndpoint:    authenticate(manager(validate(MakeUpdateInjuryEndpoint(s)))),
+		GetTeamInjuriesEndpoint: authenticate(MakeGetTeamInjuriesEndpoint(s)),
+		GetInjuryReportEndpoint: authenticate(MakeGetInjuryReportEndpoint(s)),
+	}
+}
+
+func (e Endpoints) ReportInjury(ctx context.Context, playerID int, injury dto.InjuryDTO) error {
+	request := reportInjuryRequest{playerID: playerID, Injury: injury}
+	response, err := e.ReportInjuryEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) UpdateInjury(ctx context.Context, id int, injury dto.InjuryDTO) error {
+	request := updateInjuryRequest{id: id, Injury: injury}
+	response, err := e.UpdateInjuryEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetTeamInjuries(ctx context.Context, teamID int, paging pagination.Pagination, q query.Query) error {
+	request := getTeamInjuriesRequest{teamID: teamID, Paging: paging, Query: q}
+	response, err := e.GetTeamInjuriesEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.PaginatedResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetInjuryReport(ctx context.Context, year, week int, gameStatus string) error {
+	request := getInjuryReportRequest{year: year, week: week, GameStatus: gameStatus}
+	response, err := e.GetInjuryReportEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+
+func MakeReportInjuryEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(reportInjuryRequest)
+
+		injury := req.Injury
+
+		err = service.ReportInjury(ctx, req.playerID, &injury)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: injury}, nil
+	}
+}
+func MakeUpdateInjuryEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(updateInjuryRequest)
+
+		injury := req.Injury
+
+		err = service.UpdateInjury(ctx, req.id, &injury)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: injury}, nil
+	}
+}
+func MakeGetTeamInjuriesEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getTeamInjuriesRequest)
+
+		var injuries []dto.InjuryDTO
+		var meta pagination.Meta
+
+		err = service.GetTeamInjuries(ctx, req.teamID, req.Paging, req.Query, &injuries, &meta)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.PaginatedResponse{Data: injuries, Meta: meta}, nil
+	}
+}
+func MakeGetInjuryReportEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getInjuryReportRequest)
+
+		var injuries []dto.InjuryDTO
+
+		err = service.GetInjuryReport(ctx, req.year, req.week, req.GameStatus, &injuries)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: injuries}, nil
+	}
+}
+
+type reportInjuryRequest struct {
+	playerID int
+	Injury   dto.InjuryDTO
+}
+
+type updateInjuryRequest struct {
+	id     int
+	Injury dto.InjuryDTO
+}
+
+type getTeamInjuriesRequest struct {
+	teamID int
+	Paging pagination.Pagination
+	Query  query.Query
+}
+
+type getInjuryReportRequest struct {
+	year       int
+	week       int
+	GameStatus string
+}
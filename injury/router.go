@@ -0,0 +1,189 @@
+package injury
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/logansua/nfl_app/db"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
+	"github.com/logansua/nfl_app/router"
+	"github.com/logansua/nfl_app/validation"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const gameStatusParam = "game_status"
+
+func GetServiceOptions(logger log.Logger) []httptransport.ServerOption {
+	return router.ServerOptions(logger)
+}
+
+func CreateRoutes(s Service, logger log.Logger, authenticate endpoint.Middleware) []router.Route {
+	endpoints := MakeServerEndpoints(s, authenticate)
+
+	options := GetServiceOptions(logger)
+
+	return []router.Route{
+		{
+			Name:        "Report player injury",
+			Method:      http.MethodPost,
+			Path:        "/players/{id}/injuries",
+			StrictSlash: false,
+			Handler: httptransport.NewServer(
+				endpoints.ReportInjuryEndpoint,
+				decodeReportInjuryRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Update injury",
+			Method:      http.MethodPut,
+			Path:        "/injuries/{id}",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.UpdateInjuryEndpoint,
+				decodeUpdateInjuryRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get team injuries",
+			Method:      http.MethodGet,
+			Path:        "/teams/{id}/injuries",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetTeamInjuriesEndpoint,
+				decodeGetTeamInjuriesRequest,
+				router.EncodePaginatedResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get weekly injury report",
+			Method:      http.MethodGet,
+			Path:        "/seasons/{year}/weeks/{week}/injuries",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetInjuryReportEndpoint,
+				decodeGetInjuryReportRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+	}
+}
+
+func decodeReportInjuryRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req reportInjuryRequest
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	if e := validation.DecodeJSON(r.Body, &req.Injury); e != nil {
+		return nil, e
+	}
+
+	if req.Injury.ReportedOn == "" {
+		req.Injury.ReportedOn = time.Now().Format(models.DateFormat)
+	}
+
+	req.playerID = id
+
+	return req, nil
+}
+func decodeUpdateInjuryRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req updateInjuryRequest
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	if e := validation.DecodeJSON(r.Body, &req.Injury); e != nil {
+		return nil, e
+	}
+
+	req.id = id
+
+	return req, nil
+}
+func decodeGetTeamInjuriesRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req getTeamInjuriesRequest
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	params := r.URL.Query()
+
+	paging, err := pagination.Parse(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := query.New(params, db.InjuryQueryFields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.teamID = id
+	req.Paging = paging
+	req.Query = q
+
+	return req, nil
+}
+func decodeGetInjuryReportRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req getInjuryReportRequest
+
+	params := mux.Vars(r)
+
+	year, err := strconv.Atoi(params["year"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	week, err := strconv.Atoi(params["week"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	gameStatus := r.URL.Query().Get(gameStatusParam)
+
+	if gameStatus != "" && !isGameStatus(gameStatus) {
+		return nil, query.InvalidParam(gameStatusParam, gameStatus, "must be one of "+strings.Join(models.InjuryGameStatuses, ", "))
+	}
+
+	req.year = year
+	req.week = week
+	req.GameStatus = gameStatus
+
+	return req, nil
+}
+
+func isGameStatus(value string) bool {
+	for _, status := range models.InjuryGameStatuses {
+		if value == status {
+			return true
+		}
+	}
+
+	return false
+}
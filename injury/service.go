@@ -0,0 +1,160 @@
+package injury
+
+import (
+	"context"
+	"github.com/logansua/nfl_app/db"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
+)
+
+// Service tracks injuries of players and builds the weekly injury report.
+type Service interface {
+	// Record injury of the player on the current team
+	ReportInjury(ctx context.Context, playerID int, injury *dto.InjuryDTO) error
+	// Update status of the injury or resolve it
+	UpdateInjury(ctx context.Context, id int, injury *dto.InjuryDTO) error
+	// Get open injuries of the team
+	GetTeamInjuries(ctx context.Context, teamID int, paging pagination.Pagination, q query.Query, injuries *[]dto.InjuryDTO, meta *pagination.Meta) error
+	// Get league-wide injuries of the week, all game statuses are included when it is empty
+	GetInjuryReport(ctx context.Context, year, week int, gameStatus string, injuries *[]dto.InjuryDTO) error
+	// Get injury with the team it was reported on
+	GetInjury(ctx context.Context, id int, injury *dto.InjuryDTO) error
+	// Get player with the current team, injuries are reported on it
+	GetPlayer(ctx context.Context, id int, player *dto.PlayerDTO) error
+}
+
+type service struct {
+	DB *db.DB
+}
+
+func New(dbService *db.DB) Service {
+	return &service{DB: dbService}
+}
+
+func (s *service) ReportInjury(ctx context.Context, playerID int, injury *dto.InjuryDTO) error {
+	var p models.Player
+
+	err := s.DB.Repository.FindById(&p, playerID)
+
+	if err != nil {
+		return err
+	}
+
+	injury.PlayerID = playerID
+
+	i := models.NewInjuryModel(injury)
+	i.TeamID = p.TeamID
+
+	err = s.DB.Repository.Create(&i)
+
+	if err != nil {
+		return err
+	}
+
+	*injury = models.NewInjuryDTO(i)
+
+	return nil
+}
+
+// Player and team of the injury can't be changed
+func (s *service) UpdateInjury(ctx context.Context, id int, injury *dto.InjuryDTO) error {
+	var i models.Injury
+
+	err := s.DB.Repository.FindById(&i, id)
+
+	if err != nil {
+		return err
+	}
+
+	changes := models.NewInjuryModel(injury)
+
+	i.BodyPart = changes.BodyPart
+	i.GameStatus = changes.GameStatus
+	i.PracticeStatus = changes.PracticeStatus
+	i.ReportedOn = changes.ReportedOn
+	i.ResolvedOn = changes.ResolvedOn
+
+	err = s.DB.Repository.Save(&i)
+
+	if err != nil {
+		return err
+	}
+
+	*injury = models.NewInjuryDTO(i)
+
+	return nil
+}
+
+func (s *service) GetTeamInjuries(ctx context.Context, teamID int, paging pagination.Pagination, q query.Query, injuries *[]dto.InjuryDTO, meta *pagination.Meta) error {
+	var t models.Team
+
+	err := s.DB.Repository.FindById(&t, teamID)
+
+	if err != nil {
+		return err
+	}
+
+	var i []models.Injury
+
+	err = s.DB.InjuryRepository.FindTeamInjuries(teamID, paging, q, &i, meta)
+
+	*injuries = make([]dto.InjuryDTO, len(i))
+
+	for key, value := range i {
+		(*injuries)[key] = models.NewInjuryDTO(value)
+	}
+
+	return err
+}
+
+func (s *service) GetInjuryReport(ctx context.Context, year, week int, gameStatus string, injuries *[]dto.InjuryDTO) error {
+	var season models.Season
+
+	err := s.DB.GameRepository.FindSeasonByYear(year, &season)
+
+	if err != nil {
+		return err
+	}
+
+	var i []models.Injury
+
+	err = s.DB.InjuryRepository.FindWeeklyReport(int(season.ID), week, gameStatus, &i)
+
+	*injuries = make([]dto.InjuryDTO, len(i))
+
+	for key, value := range i {
+		(*injuries)[key] = models.NewInjuryDTO(value)
+	}
+
+	return err
+}
+
+func (s *service) GetInjury(ctx context.Context, id int, injury *dto.InjuryDTO) error {
+	var i models.Injury
+
+	err := s.DB.Repository.FindById(&i, id)
+
+	if err != nil {
+		return err
+	}
+
+	*injury = models.NewInjuryDTO(i)
+
+	return nil
+}
+
+func (s *service) GetPlayer(ctx context.Context, id int, player *dto.PlayerDTO) error {
+	var p models.Player
+
+	err := s.DB.Repository.FindById(&p, id)
+
+	if err != nil {
+		return err
+	}
+
+	*player = models.NewPlayerDTO(p)
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package injury
+
+import (
+	"context"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+	"time"
+)
+
+const maxLength = 255
+
+func validateInjury(injury dto.InjuryDTO) error {
+	errs := validation.New().
+		Required("body_part", injury.BodyPart).
+		MaxLength("body_part", injury.BodyPart, maxLength).
+		OneOf("game_status", injury.GameStatus, models.InjuryGameStatuses...).
+		OneOf("practice_status", injury.PracticeStatus, models.PracticeStatuses...)
+
+	reportedOn, err := time.Parse(models.DateFormat, injury.ReportedOn)
+
+	errs.Check(err == nil && !reportedOn.After(time.Now()), "reported_on", validation.CodeInvalid)
+
+	if injury.ResolvedOn != nil {
+		resolvedOn, e := time.Parse(models.DateFormat, *injury.ResolvedOn)
+
+		errs.Check(e == nil && (err != nil || !resolvedOn.Before(reportedOn)), "resolved_on", validation.CodeInvalid)
+	}
+
+	return errs.Err()
+}
+
+func (r reportInjuryRequest) Validate(_ context.Context) error {
+	return validateInjury(r.Injury)
+}
+
+func (r updateInjuryRequest) Validate(_ context.Context) error {
+	return validateInjury(r.Injury)
+}
@@ -0,0 +1,41 @@
+package injury
+
+import (
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func stringPtr(v string) *string {
+	return &v
+}
+
+func TestValidateInjury(t *testing.T) {
+	injury := dto.InjuryDTO{
+		BodyPart:       "Knee",
+		GameStatus:     models.InjuryQuestionable,
+		PracticeStatus: models.PracticeLimited,
+		ReportedOn:     "2019-01-10",
+		ResolvedOn:     stringPtr("2019-01-20"),
+	}
+
+	assert.Nil(t, validateInjury(injury))
+
+	injury.GameStatus = "probable"
+	injury.ReportedOn = "2019-01-32"
+	injury.ResolvedOn = stringPtr("2019-01-05")
+
+	assert.Equal(t, validation.Errors{
+		{Field: "game_status", Code: validation.CodeInvalid},
+		{Field: "reported_on", Code: validation.CodeInvalid},
+	}, validateInjury(injury))
+
+	injury.GameStatus = models.InjuryOut
+	injury.ReportedOn = "2019-01-10"
+
+	assert.Equal(t, validation.Errors{
+		{Field: "resolved_on", Code: validation.CodeInvalid},
+	}, validateInjury(injury))
+}
@@ -0,0 +1,325 @@
+package league
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/logansua/nfl_app/auth"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/query"
+	"github.com/logansua/nfl_app/utils"
+	"github.com/logansua/nfl_app/validation"
+)
+
+type Endpoints struct {
+	CreateConferenceEndpoint endpoint.Endpoint
+	GetConferencesEndpoint   endpoint.Endpoint
+	GetConferenceEndpoint    endpoint.Endpoint
+	UpdateConferenceEndpoint endpoint.Endpoint
+	DeleteConferenceEndpoint endpoint.Endpoint
+	CreateDivisionEndpoint   endpoint.Endpoint
+	GetDivisionsEndpoint     endpoint.Endpoint
+	GetDivisionEndpoint      endpoint.Endpoint
+	UpdateDivisionEndpoint   endpoint.Endpoint
+	DeleteDivisionEndpoint   endpoint.Endpoint
+}
+
+func MakeServerEndpoints(s Service, authenticate endpoint.Middleware) Endpoints {
+	validate := validation.Middleware()
+	admin := auth.RequireRole(models.RoleAdmin)
+
+	return Endpoints{
+		CreateConferenceEndpoint: authenticate(admin(validate(MakeCreateConferenceEndpoint(s)))),
+		GetConferencesEndpoint:   authenticate(MakeGetConferencesEndpoint(s)),
+		GetConferenceEndpoint:    authenticate(MakeGetConferenceEndpoint(s)),
+		UpdateConferenceEndpoint: authenticate(admin(validate(MakeUpdateConferenceEndpoint(s)))),
+		DeleteConferenceEndpoint: authenticate(admin(MakeDeleteConferenceEndpoint(s))),
+		CreateDivisionEndpoint:   authenticate(admin(validate(MakeCreateDivisionEndpoint(s)))),
+		GetDivisionsEndpoint:     authenticate(MakeGetDivisionsEndpoint(s)),
+		GetDivisionEndpoint:      authenticate(MakeGetDivisionEndpoint(s)),
+		UpdateDivisionEndpoint:   authenticate(admin(validate(MakeUpdateDivisionEndpoint(s)))),
+		DeleteDivisionEndpoint:   authenticate(admin(MakeDeleteDivisionEndpoint(s))),
+	}
+}
+
+func (e Endpoints) CreateConference(ctx context.Context, c dto.ConferenceDTO) error {
+	request := createConferenceRequest{Conference: c}
+	response, err := e.CreateConferenceEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetConferences(ctx context.Context) error {
+	request := getConferencesRequest{}
+	response, err := e.GetConferencesEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetConference(ctx context.Context, id int) error {
+	request := idRequest{id: id}
+	response, err := e.GetConferenceEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) UpdateConference(ctx context.Context, id int, c dto.ConferenceDTO) error {
+	request := updateConferenceRequest{id: id, Conference: c}
+	response, err := e.UpdateConferenceEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) DeleteConference(ctx context.Context, id int) error {
+	request := idRequest{id: id}
+	_, err := e.DeleteConferenceEndpoint(ctx, request)
+
+	return err
+}
+func (e Endpoints) CreateDivision(ctx context.Context, d dto.DivisionDTO) error {
+	request := createDivisionRequest{Division: d}
+	response, err := e.CreateDivisionEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetDivisions(ctx context.Context, q query.Query) error {
+	request := getDivisionsRequest{Query: q}
+	response, err := e.GetDivisionsEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetDivision(ctx context.Context, id int) error {
+	request := idRequest{id: id}
+	response, err := e.GetDivisionEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) UpdateDivision(ctx context.Context, id int, d dto.DivisionDTO) error {
+	request := updateDivisionRequest{id: id, Division: d}
+	response, err := e.UpdateDivisionEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) DeleteDivision(ctx context.Context, id int) error {
+	request := idRequest{id: id}
+	_, err := e.DeleteDivisionEndpoint(ctx, request)
+
+	return err
+}
+
+func MakeCreateConferenceEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(createConferenceRequest)
+
+		c := req.Conference
+
+		err = service.CreateConference(ctx, &c)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: c}, nil
+	}
+}
+func MakeGetConferencesEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		var conferences []dto.ConferenceDTO
+
+		err = service.GetConferences(ctx, &conferences)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: conferences}, nil
+	}
+}
+func MakeGetConferenceEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(idRequest)
+
+		var conference dto.ConferenceDTO
+
+		err = service.GetConference(ctx, req.id, &conference)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: conference}, nil
+	}
+}
+func MakeUpdateConferenceEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(updateConferenceRequest)
+
+		c := req.Conference
+
+		err = service.UpdateConference(ctx, req.id, &c)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: c}, nil
+	}
+}
+func MakeDeleteConferenceEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(idRequest)
+
+		err = service.DeleteConference(ctx, req.id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+}
+func MakeCreateDivisionEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(createDivisionRequest)
+
+		d := req.Division
+
+		err = service.CreateDivision(ctx, &d)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: d}, nil
+	}
+}
+func MakeGetDivisionsEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getDivisionsRequest)
+
+		var divisions []dto.DivisionDTO
+
+		err = service.GetDivisions(ctx, req.Query, &divisions)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: divisions}, nil
+	}
+}
+func MakeGetDivisionEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(idRequest)
+
+		var division dto.DivisionDTO
+
+		err = service.GetDivision(ctx, req.id, &division)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: division}, nil
+	}
+}
+func MakeUpdateDivisionEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(updateDivisionRequest)
+
+		d := req.Division
+
+		err = service.UpdateDivision(ctx, req.id, &d)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: d}, nil
+	}
+}
+func MakeDeleteDivisionEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(idRequest)
+
+		err = service.DeleteDivision(ctx, req.id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+}
+
+type createConferenceRequest struct {
+	Conference dto.ConferenceDTO
+}
+
+type getConferencesRequest struct{}
+
+type updateConferenceRequest struct {
+	id         int
+	Conference dto.ConferenceDTO
+}
+
+type createDivisionRequest struct {
+	Division dto.DivisionDTO
+}
+
+type getDivisionsRequest struct {
+	Query query.Query
+}
+
+type updateDivisionRequest struct {
+	id       int
+	Division dto.DivisionDTO
+}
+
+type idRequest struct {
+	id int
+}
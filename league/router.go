@@ -0,0 +1,230 @@
+package league
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/logansua/nfl_app/db"
+	"github.com/logansua/nfl_app/query"
+	"github.com/logansua/nfl_app/router"
+	"github.com/logansua/nfl_app/validation"
+	"net/http"
+	"strconv"
+)
+
+func GetServiceOptions(logger log.Logger) []httptransport.ServerOption {
+	return router.ServerOptions(logger)
+}
+
+func CreateRoutes(s Service, logger log.Logger, authenticate endpoint.Middleware) []router.Route {
+	endpoints := MakeServerEndpoints(s, authenticate)
+
+	options := GetServiceOptions(logger)
+
+	return []router.Route{
+		{
+			Name:        "Create conference",
+			Method:      http.MethodPost,
+			Path:        "/conferences",
+			StrictSlash: false,
+			Handler: httptransport.NewServer(
+				endpoints.CreateConferenceEndpoint,
+				decodeCreateConferenceRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get conferences",
+			Method:      http.MethodGet,
+			Path:        "/conferences",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetConferencesEndpoint,
+				decodeGetConferencesRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get conference",
+			Method:      http.MethodGet,
+			Path:        "/conferences/{id}",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetConferenceEndpoint,
+				decodeIdRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Update conference",
+			Method:      http.MethodPut,
+			Path:        "/conferences/{id}",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.UpdateConferenceEndpoint,
+				decodeUpdateConferenceRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Delete conference",
+			Method:      http.MethodDelete,
+			Path:        "/conferences/{id}",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.DeleteConferenceEndpoint,
+				decodeIdRequest,
+				router.EncodeNoContentResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Create division",
+			Method:      http.MethodPost,
+			Path:        "/divisions",
+			StrictSlash: false,
+			Handler: httptransport.NewServer(
+				endpoints.CreateDivisionEndpoint,
+				decodeCreateDivisionRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get divisions",
+			Method:      http.MethodGet,
+			Path:        "/divisions",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetDivisionsEndpoint,
+				decodeGetDivisionsRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get division",
+			Method:      http.MethodGet,
+			Path:        "/divisions/{id}",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetDivisionEndpoint,
+				decodeIdRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Update division",
+			Method:      http.MethodPut,
+			Path:        "/divisions/{id}",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.UpdateDivisionEndpoint,
+				decodeUpdateDivisionRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Delete division",
+			Method:      http.MethodDelete,
+			Path:        "/divisions/{id}",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.DeleteDivisionEndpoint,
+				decodeIdRequest,
+				router.EncodeNoContentResponse,
+				options...,
+			),
+		},
+	}
+}
+
+func decodeCreateConferenceRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req createConferenceRequest
+
+	if e := validation.DecodeJSON(r.Body, &req.Conference); e != nil {
+		return nil, e
+	}
+
+	return req, nil
+}
+func decodeGetConferencesRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	return getConferencesRequest{}, nil
+}
+func decodeUpdateConferenceRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req updateConferenceRequest
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	if e := validation.DecodeJSON(r.Body, &req.Conference); e != nil {
+		return nil, e
+	}
+
+	req.id = id
+
+	return req, nil
+}
+func decodeCreateDivisionRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req createDivisionRequest
+
+	if e := validation.DecodeJSON(r.Body, &req.Division); e != nil {
+		return nil, e
+	}
+
+	return req, nil
+}
+func decodeGetDivisionsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req getDivisionsRequest
+
+	q, err := query.New(r.URL.Query(), db.DivisionQueryFields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Query = q
+
+	return req, nil
+}
+func decodeUpdateDivisionRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req updateDivisionRequest
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	if e := validation.DecodeJSON(r.Body, &req.Division); e != nil {
+		return nil, e
+	}
+
+	req.id = id
+
+	return req, nil
+}
+func decodeIdRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req idRequest
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.id = id
+
+	return req, nil
+}
@@ -0,0 +1,198 @@
+package league
+
+import (
+	"context"
+	"errors"
+	"github.com/logansua/nfl_app/db"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/query"
+	"github.com/logansua/nfl_app/validation"
+)
+
+// Service manages conferences and divisions teams are grouped by.
+type Service interface {
+	// Create conference
+	CreateConference(ctx context.Context, conference *dto.ConferenceDTO) error
+	// Get all conferences with their divisions
+	GetConferences(ctx context.Context, conferences *[]dto.ConferenceDTO) error
+	// Get single conference by ID with its divisions
+	GetConference(ctx context.Context, id int, conference *dto.ConferenceDTO) error
+	// Update conference by ID
+	UpdateConference(ctx context.Context, id int, conference *dto.ConferenceDTO) error
+	// Delete conference with its divisions by ID
+	DeleteConference(ctx context.Context, id int) error
+	// Create division
+	CreateDivision(ctx context.Context, division *dto.DivisionDTO) error
+	// Get divisions matching the query
+	GetDivisions(ctx context.Context, q query.Query, divisions *[]dto.DivisionDTO) error
+	// Get single division by ID
+	GetDivision(ctx context.Context, id int, division *dto.DivisionDTO) error
+	// Update division by ID
+	UpdateDivision(ctx context.Context, id int, division *dto.DivisionDTO) error
+	// Delete division by ID, its teams are left without division
+	DeleteDivision(ctx context.Context, id int) error
+}
+
+type service struct {
+	DB *db.DB
+}
+
+func New(dbService *db.DB) Service {
+	return &service{DB: dbService}
+}
+
+func (s *service) CreateConference(ctx context.Context, conference *dto.ConferenceDTO) error {
+	c := models.NewConferenceModel(conference)
+
+	err := s.DB.Repository.Create(&c)
+
+	*conference = models.NewConferenceDTO(c)
+
+	return err
+}
+
+func (s *service) GetConferences(ctx context.Context, conferences *[]dto.ConferenceDTO) error {
+	var c []models.Conference
+
+	err := s.DB.LeagueRepository.FindConferences(&c)
+
+	*conferences = make([]dto.ConferenceDTO, len(c))
+
+	for key, value := range c {
+		(*conferences)[key] = models.NewConferenceDTO(value)
+	}
+
+	return err
+}
+
+func (s *service) GetConference(ctx context.Context, id int, conference *dto.ConferenceDTO) error {
+	var c models.Conference
+
+	err := s.DB.LeagueRepository.FindConference(id, &c)
+
+	*conference = models.NewConferenceDTO(c)
+
+	return err
+}
+
+func (s *service) UpdateConference(ctx context.Context, id int, conference *dto.ConferenceDTO) error {
+	var c models.Conference
+
+	err := s.DB.Repository.FindById(&c, id)
+
+	if err != nil {
+		return err
+	}
+
+	c.Name = conference.Name
+
+	err = s.DB.Repository.Save(&c)
+
+	*conference = models.NewConferenceDTO(c)
+
+	return err
+}
+
+func (s *service) DeleteConference(ctx context.Context, id int) error {
+	var c models.Conference
+
+	err := s.DB.Repository.FindById(&c, id)
+
+	if err != nil {
+		return err
+	}
+
+	return s.DB.Repository.Delete(&c, id)
+}
+
+func (s *service) CreateDivision(ctx context.Context, division *dto.DivisionDTO) error {
+	d := models.NewDivisionModel(division)
+
+	err := s.checkConference(d.ConferenceID)
+
+	if err != nil {
+		return err
+	}
+
+	err = s.DB.Repository.Create(&d)
+
+	*division = models.NewDivisionDTO(d)
+
+	return err
+}
+
+// Referential check of division conference
+func (s *service) checkConference(conferenceID int) error {
+	var c models.Conference
+
+	err := s.DB.Repository.FindById(&c, conferenceID)
+
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return validation.Errors{{Field: "conference_id", Code: validation.CodeNotFound}}
+	}
+
+	return err
+}
+
+func (s *service) GetDivisions(ctx context.Context, q query.Query, divisions *[]dto.DivisionDTO) error {
+	var d []models.Division
+
+	err := s.DB.LeagueRepository.FindDivisions(q, &d)
+
+	*divisions = make([]dto.DivisionDTO, len(d))
+
+	for key, value := range d {
+		(*divisions)[key] = models.NewDivisionDTO(value)
+	}
+
+	return err
+}
+
+func (s *service) GetDivision(ctx context.Context, id int, division *dto.DivisionDTO) error {
+	var d models.Division
+
+	err := s.DB.LeagueRepository.FindDivision(id, &d)
+
+	*division = models.NewDivisionDTO(d)
+
+	return err
+}
+
+func (s *service) UpdateDivision(ctx context.Context, id int, division *dto.DivisionDTO) error {
+	var d models.Division
+
+	err := s.DB.Repository.FindById(&d, id)
+
+	if err != nil {
+		return err
+	}
+
+	err = s.checkConference(division.ConferenceID)
+
+	if err != nil {
+		return err
+	}
+
+	d.ConferenceID = division.ConferenceID
+	d.Name = division.Name
+
+	err = s.DB.Repository.Save(&d)
+
+	*division = models.NewDivisionDTO(d)
+
+	return err
+}
+
+func (s *service) DeleteDivision(ctx context.Context, id int) error {
+	var d models.Division
+
+	err := s.DB.Repository.FindById(&d, id)
+
+	if err != nil {
+		return err
+	}
+
+	return s.DB.Repository.Delete(&d, id)
+}
@@ -0,0 +1,40 @@
+package league
+
+import (
+	"context"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+)
+
+const maxLength = 255
+
+func validateConference(conference dto.ConferenceDTO) error {
+	return validation.New().
+		Required("name", conference.Name).
+		MaxLength("name", conference.Name, maxLength).
+		Err()
+}
+
+func validateDivision(division dto.DivisionDTO) error {
+	return validation.New().
+		ID("conference_id", division.ConferenceID).
+		Required("name", division.Name).
+		MaxLength("name", division.Name, maxLength).
+		Err()
+}
+
+func (r createConferenceRequest) Validate(_ context.Context) error {
+	return validateConference(r.Conference)
+}
+
+func (r updateConferenceRequest) Validate(_ context.Context) error {
+	return validateConference(r.Conference)
+}
+
+func (r createDivisionRequest) Validate(_ context.Context) error {
+	return validateDivision(r.Division)
+}
+
+func (r updateDivisionRequest) Validate(_ context.Context) error {
+	return validateDivision(r.Division)
+}
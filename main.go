@@ -1,15 +1,26 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"github.com/go-kit/kit/log"
 	"github.com/joho/godotenv"
+	"github.com/logansua/nfl_app/auth"
 	"github.com/logansua/nfl_app/bucket"
+	"github.com/logansua/nfl_app/contract"
 	"github.com/logansua/nfl_app/db"
+	"github.com/logansua/nfl_app/draft"
+	"github.com/logansua/nfl_app/game"
+	"github.com/logansua/nfl_app/injury"
+	"github.com/logansua/nfl_app/league"
 	"github.com/logansua/nfl_app/player"
+	"github.com/logansua/nfl_app/ratelimit"
 	"github.com/logansua/nfl_app/router"
+	"github.com/logansua/nfl_app/standings"
+	"github.com/logansua/nfl_app/stats"
 	"github.com/logansua/nfl_app/team"
+	"github.com/logansua/nfl_app/transaction"
 	"net/http"
 	"os"
 	"os/signal"
@@ -17,6 +28,8 @@ import (
 )
 
 func main() {
+	httpAddr := flag.String("http.addr", "", "HTTP listen address, :APP_PORT by default")
+
 	flag.Parse()
 
 	var logger log.Logger
@@ -32,6 +45,24 @@ func main() {
 		panic("Error loading .env file")
 	}
 
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(flag.Args()[1:], logger); err != nil {
+			logger.Log("migrate", err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	if flag.Arg(0) == "user" {
+		if err := runUser(flag.Args()[1:], logger); err != nil {
+			logger.Log("user", err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
 	dbService, err := db.New()
 
 	if err != nil {
@@ -44,36 +75,127 @@ func main() {
 		panic(err)
 	}
 
+	authService, err := auth.New(dbService)
+
+	if err != nil {
+		panic(err)
+	}
+
+	authenticate := auth.Middleware(authService)
+	authRoutes := auth.CreateRoutes(authService, logger)
+
 	teamService := team.New(dbService, bucketService)
-	teamRoutes := team.CreateRoutes(teamService, logger)
+	teamRoutes := team.CreateRoutes(teamService, logger, authenticate)
 
 	playerService := player.New(dbService, bucketService, teamService)
-	playerRoutes := player.CreateRoutes(playerService, logger)
+	playerRoutes := player.CreateRoutes(playerService, logger, authenticate)
+
+	gameService := game.New(dbService, teamService)
+	gameRoutes := game.CreateRoutes(gameService, logger, authenticate)
+
+	leagueService := league.New(dbService)
+	leagueRoutes := league.CreateRoutes(leagueService, logger, authenticate)
+
+	transactionService := transaction.New(dbService, teamService)
+	transactionRoutes := transaction.CreateRoutes(transactionService, logger, authenticate)
+
+	statsService := stats.New(dbService)
+	statsRoutes := stats.CreateRoutes(statsService, logger, authenticate)
+
+	standingsService := standings.New(dbService)
+	standingsRoutes := standings.CreateRoutes(standingsService, logger, authenticate)
+
+	injuryService := injury.New(dbService)
+	injuryRoutes := injury.CreateRoutes(injuryService, logger, authenticate)
+
+	draftService := draft.New(dbService)
+	draftRoutes := draft.CreateRoutes(draftService, logger, authenticate)
+
+	contractService := contract.New(dbService)
+	contractRoutes := contract.CreateRoutes(contractService, logger, authenticate)
+
+	bucketRoutes := bucket.CreateRoutes(bucketService)
 
-	routes := append(playerRoutes, teamRoutes...)
+	routes := append(authRoutes, playerRoutes...)
+	routes = append(routes, teamRoutes...)
+	routes = append(routes, leagueRoutes...)
+	routes = append(routes, gameRoutes...)
+	routes = append(routes, standingsRoutes...)
+	routes = append(routes, statsRoutes...)
+	routes = append(routes, transactionRoutes...)
+	routes = append(routes, injuryRoutes...)
+	routes = append(routes, draftRoutes...)
+	routes = append(routes, contractRoutes...)
+	routes = append(routes, bucketRoutes...)
+
+	proxies, err := ratelimit.TrustedProxies()
+
+	if err != nil {
+		panic(err)
+	}
+
+	limiter := ratelimit.New(ratelimit.ByAPIKey(proxies, func(ctx context.Context, authorization string) bool {
+		return authService.VerifyAPIKey(ctx, authorization) == nil
+	}), logger)
 
 	var handler http.Handler
 	{
 		handler = router.New(routes)
+		handler = limiter.Middleware(handler)
 	}
 
-	errs := make(chan error)
+	if *httpAddr == "" {
+		*httpAddr = fmt.Sprintf(":%s", os.Getenv("APP_PORT"))
+	}
 
-	go func() {
-		c := make(chan os.Signal)
+	server := newServer(*httpAddr, handler)
 
-		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
+	// Live draft streams would otherwise keep the server from shutting down until the deadline
+	server.RegisterOnShutdown(draftService.Close)
 
-		errs <- fmt.Errorf("%playerService", <-c)
-	}()
-
-	httpAddr := flag.String("http.addr", fmt.Sprintf(":%s", os.Getenv("APP_PORT")), "HTTP listen address")
+	errs := make(chan error, 1)
 
 	go func() {
-		logger.Log("transport", "HTTP", "addr", *httpAddr)
+		logger.Log("transport", "HTTP", "addr", server.Addr)
 
-		errs <- http.ListenAndServe(*httpAddr, handler)
+		if err := server.ListenAndServe(); err != http.ErrServerClosed {
+			errs <- err
+		}
 	}()
 
-	logger.Log("exit", <-errs)
+	signals := make(chan os.Signal, 1)
+
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	exitCode := 0
+
+	select {
+	case err := <-errs:
+		logger.Log("transport", "HTTP", "err", err)
+		exitCode = 1
+	case sig := <-signals:
+		logger.Log("signal", sig, "shutdown", "draining")
+
+		ctx, cancel := context.WithTimeout(context.Background(), envDuration("HTTP_SHUTDOWN_TIMEOUT", defaultShutdownTimeout))
+
+		// Requests still running after the deadline are cut off
+		if err := server.Shutdown(ctx); err != nil {
+			logger.Log("shutdown", err)
+			exitCode = 1
+		}
+
+		cancel()
+	}
+
+	// Clients are closed in reverse order of creation once no request uses them
+	if err := bucketService.Close(); err != nil {
+		logger.Log("close", "storage", "err", err)
+	}
+
+	if err := dbService.DB.Close(); err != nil {
+		logger.Log("close", "db", "err", err)
+	}
+
+	logger.Log("exit", exitCode)
+	os.Exit(exitCode)
 }
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/go-kit/kit/log"
+	"github.com/logansua/nfl_app/db"
+	"github.com/logansua/nfl_app/migrate"
+	"os"
+	"time"
+)
+
+const migrateUsage = "usage: migrate up|down|status|create NAME"
+
+// Run migrate subcommand
+func runMigrate(args []string, logger log.Logger) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	dir := os.Getenv("MIGRATIONS_PATH")
+
+	if dir == "" {
+		dir = "./migrations"
+	}
+
+	if args[0] == "create" {
+		if len(args) < 2 {
+			return errors.New(migrateUsage)
+		}
+
+		paths, err := migrate.Create(dir, args[1], time.Now())
+
+		for _, path := range paths {
+			logger.Log("migration", "created", "path", path)
+		}
+
+		return err
+	}
+
+	migrations, err := migrate.Load(dir)
+
+	if err != nil {
+		return err
+	}
+
+	dbService, err := db.New()
+
+	if err != nil {
+		return err
+	}
+
+	defer dbService.DB.Close()
+
+	migrator := migrate.New(dbService.DB, migrations)
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up()
+
+		for _, m := range applied {
+			logger.Log("migration", "applied", "version", m.Version, "name", m.Name)
+		}
+
+		return err
+	case "down":
+		reverted, err := migrator.Down()
+
+		if reverted != nil {
+			logger.Log("migration", "reverted", "version", reverted.Version, "name", reverted.Name)
+		}
+
+		return err
+	case "status":
+		statuses, err := migrator.Status()
+
+		for _, s := range statuses {
+			state := "pending"
+
+			if s.AppliedAt != nil {
+				state = fmt.Sprintf("applied at %s", s.AppliedAt.Format(time.RFC3339))
+			}
+
+			fmt.Printf("%d_%s\t%s\n", s.Version, s.Name, state)
+		}
+
+		return err
+	default:
+		return errors.New(migrateUsage)
+	}
+}
@@ -0,0 +1,46 @@
+package migrate
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoad(t *testing.T) {
+	migrations, err := Load("../migrations")
+
+	assert.Nil(t, err)
+	assert.NotEmpty(t, migrations)
+
+	for key, migration := range migrations {
+		assert.NotEmpty(t, migration.Up)
+		assert.NotEmpty(t, migration.Down)
+
+		if key > 0 {
+			assert.True(t, migrations[key-1].Version < migration.Version)
+		}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrations")
+
+	assert.Nil(t, err)
+
+	defer os.RemoveAll(dir)
+
+	paths, err := Create(dir, "Add Player Position", time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "20190102030405_add_player_position.up.sql"),
+		filepath.Join(dir, "20190102030405_add_player_position.down.sql"),
+	}, paths)
+
+	_, err = Load(dir)
+
+	assert.NotNil(t, err, "empty up script must be rejected")
+}
@@ -0,0 +1,191 @@
+package migrate
+
+import (
+	"fmt"
+	"github.com/jinzhu/gorm"
+	"github.com/logansua/nfl_app/db"
+	"time"
+)
+
+// Key of advisory lock preventing concurrent runs of migrations
+const lockKey = 7262358
+
+type Migrator struct {
+	DB         *gorm.DB
+	Migrations []Migration
+}
+
+// Status of single migration
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+type schemaMigration struct {
+	Version   int64 `gorm:"primary_key"`
+	Name      string
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+func New(database *gorm.DB, migrations []Migration) *Migrator {
+	return &Migrator{DB: database, Migrations: migrations}
+}
+
+// Apply all pending migrations in order, every migration runs in its own transaction
+func (m *Migrator) Up() ([]Migration, error) {
+	if err := m.init(); err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+
+	for _, migration := range m.Migrations {
+		done := false
+
+		err := db.WithTransaction(m.DB, func(tx *gorm.DB) error {
+			if err := lock(tx); err != nil {
+				return err
+			}
+
+			var count int
+
+			if err := tx.Model(&schemaMigration{}).Where("version = ?", migration.Version).Count(&count).Error; err != nil {
+				return err
+			}
+
+			if count > 0 {
+				return nil
+			}
+
+			if err := tx.Exec(migration.Up).Error; err != nil {
+				return fmt.Errorf("migration %d_%s: %v", migration.Version, migration.Name, err)
+			}
+
+			done = true
+
+			return tx.Create(&schemaMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now().UTC(),
+			}).Error
+		})
+
+		if err != nil {
+			return applied, err
+		}
+
+		if done {
+			applied = append(applied, migration)
+		}
+	}
+
+	return applied, nil
+}
+
+// Roll back the last applied migration, nil is returned when there is nothing to roll back
+func (m *Migrator) Down() (*Migration, error) {
+	if err := m.init(); err != nil {
+		return nil, err
+	}
+
+	var reverted *Migration
+
+	err := db.WithTransaction(m.DB, func(tx *gorm.DB) error {
+		if err := lock(tx); err != nil {
+			return err
+		}
+
+		var last schemaMigration
+
+		err := tx.Order("version DESC").First(&last).Error
+
+		if gorm.IsRecordNotFoundError(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		migration, ok := m.find(last.Version)
+
+		if !ok {
+			return fmt.Errorf("migration %d_%s is applied but its scripts are missing", last.Version, last.Name)
+		}
+
+		if migration.Down == "" {
+			return fmt.Errorf("migration %d_%s has no down script", migration.Version, migration.Name)
+		}
+
+		if err := tx.Exec(migration.Down).Error; err != nil {
+			return fmt.Errorf("migration %d_%s: %v", migration.Version, migration.Name, err)
+		}
+
+		reverted = &migration
+
+		return tx.Delete(&last).Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return reverted, nil
+}
+
+// List all known migrations with time they were applied at
+func (m *Migrator) Status() ([]Status, error) {
+	if err := m.init(); err != nil {
+		return nil, err
+	}
+
+	var rows []schemaMigration
+
+	if err := m.DB.Order("version ASC").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	appliedAt := map[int64]time.Time{}
+
+	for _, row := range rows {
+		appliedAt[row.Version] = row.AppliedAt
+	}
+
+	statuses := make([]Status, len(m.Migrations))
+
+	for key, migration := range m.Migrations {
+		statuses[key] = Status{Migration: migration}
+
+		if t, ok := appliedAt[migration.Version]; ok {
+			statuses[key].AppliedAt = &t
+		}
+	}
+
+	return statuses, nil
+}
+
+func (m *Migrator) init() error {
+	return m.DB.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    BIGINT PRIMARY KEY,
+		name       VARCHAR(255) NOT NULL,
+		applied_at TIMESTAMP WITH TIME ZONE NOT NULL
+	)`).Error
+}
+
+func (m *Migrator) find(version int64) (Migration, bool) {
+	for _, migration := range m.Migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+
+	return Migration{}, false
+}
+
+func lock(tx *gorm.DB) error {
+	return tx.Exec("SELECT pg_advisory_xact_lock(?)", lockKey).Error
+}
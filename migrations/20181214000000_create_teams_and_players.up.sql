@@ -0,0 +1,18 @@
+CREATE TABLE teams (
+    id         SERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE,
+    name       VARCHAR(255) NOT NULL,
+    logo       VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE TABLE players (
+    id         SERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE,
+    name       VARCHAR(255) NOT NULL,
+    avatar     VARCHAR(255) NOT NULL DEFAULT '',
+    team_id    INTEGER REFERENCES teams (id) ON DELETE CASCADE ON UPDATE NO ACTION
+);
+
+CREATE INDEX idx_players_team_id ON players (team_id);
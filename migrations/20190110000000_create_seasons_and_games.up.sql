@@ -0,0 +1,26 @@
+CREATE TABLE seasons (
+    id         SERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE,
+    year       INTEGER NOT NULL UNIQUE
+);
+
+CREATE TABLE games (
+    id           SERIAL PRIMARY KEY,
+    created_at   TIMESTAMP WITH TIME ZONE,
+    updated_at   TIMESTAMP WITH TIME ZONE,
+    season_id    INTEGER NOT NULL REFERENCES seasons (id) ON DELETE CASCADE,
+    week         INTEGER NOT NULL,
+    home_team_id INTEGER NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    away_team_id INTEGER NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    kickoff_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+    venue        VARCHAR(255) NOT NULL DEFAULT '',
+    status       VARCHAR(32) NOT NULL DEFAULT 'scheduled',
+    home_score   INTEGER NOT NULL DEFAULT 0,
+    away_score   INTEGER NOT NULL DEFAULT 0,
+    CHECK (home_team_id <> away_team_id)
+);
+
+CREATE INDEX idx_games_season_id_week ON games (season_id, week);
+CREATE INDEX idx_games_home_team_id ON games (home_team_id);
+CREATE INDEX idx_games_away_team_id ON games (away_team_id);
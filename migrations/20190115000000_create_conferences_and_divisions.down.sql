@@ -0,0 +1,3 @@
+ALTER TABLE teams DROP COLUMN division_id;
+DROP TABLE divisions;
+DROP TABLE conferences;
@@ -0,0 +1,19 @@
+CREATE TABLE conferences (
+    id         SERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE,
+    name       VARCHAR(255) NOT NULL UNIQUE
+);
+
+CREATE TABLE divisions (
+    id            SERIAL PRIMARY KEY,
+    created_at    TIMESTAMP WITH TIME ZONE,
+    updated_at    TIMESTAMP WITH TIME ZONE,
+    conference_id INTEGER NOT NULL REFERENCES conferences (id) ON DELETE CASCADE,
+    name          VARCHAR(255) NOT NULL,
+    UNIQUE (conference_id, name)
+);
+
+ALTER TABLE teams ADD COLUMN division_id INTEGER REFERENCES divisions (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_teams_division_id ON teams (division_id);
@@ -0,0 +1,4 @@
+-- Teams are kept since players and games may reference them
+UPDATE teams SET division_id = NULL;
+DELETE FROM divisions;
+DELETE FROM conferences;
@@ -0,0 +1,63 @@
+-- Two conferences of four divisions with 32 teams. Teams that already exist are matched by name
+-- and only assigned to their division.
+INSERT INTO conferences (created_at, updated_at, name)
+VALUES (now(), now(), 'AFC'),
+       (now(), now(), 'NFC')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO divisions (created_at, updated_at, conference_id, name)
+SELECT now(), now(), conferences.id, divisions.name
+FROM conferences
+CROSS JOIN (VALUES ('East'), ('North'), ('South'), ('West')) AS divisions (name)
+WHERE conferences.name IN ('AFC', 'NFC')
+ON CONFLICT (conference_id, name) DO NOTHING;
+
+CREATE TEMPORARY TABLE league_teams (name, conference, division) AS
+VALUES
+    ('Buffalo Bills', 'AFC', 'East'),
+    ('Miami Dolphins', 'AFC', 'East'),
+    ('New England Patriots', 'AFC', 'East'),
+    ('New York Jets', 'AFC', 'East'),
+    ('Baltimore Ravens', 'AFC', 'North'),
+    ('Cincinnati Bengals', 'AFC', 'North'),
+    ('Cleveland Browns', 'AFC', 'North'),
+    ('Pittsburgh Steelers', 'AFC', 'North'),
+    ('Houston Texans', 'AFC', 'South'),
+    ('Indianapolis Colts', 'AFC', 'South'),
+    ('Jacksonville Jaguars', 'AFC', 'South'),
+    ('Tennessee Titans', 'AFC', 'South'),
+    ('Denver Broncos', 'AFC', 'West'),
+    ('Kansas City Chiefs', 'AFC', 'West'),
+    ('Las Vegas Raiders', 'AFC', 'West'),
+    ('Los Angeles Chargers', 'AFC', 'West'),
+    ('Dallas Cowboys', 'NFC', 'East'),
+    ('New York Giants', 'NFC', 'East'),
+    ('Philadelphia Eagles', 'NFC', 'East'),
+    ('Washington Commanders', 'NFC', 'East'),
+    ('Chicago Bears', 'NFC', 'North'),
+    ('Detroit Lions', 'NFC', 'North'),
+    ('Green Bay Packers', 'NFC', 'North'),
+    ('Minnesota Vikings', 'NFC', 'North'),
+    ('Atlanta Falcons', 'NFC', 'South'),
+    ('Carolina Panthers', 'NFC', 'South'),
+    ('New Orleans Saints', 'NFC', 'South'),
+    ('Tampa Bay Buccaneers', 'NFC', 'South'),
+    ('Arizona Cardinals', 'NFC', 'West'),
+    ('Los Angeles Rams', 'NFC', 'West'),
+    ('San Francisco 49ers', 'NFC', 'West'),
+    ('Seattle Seahawks', 'NFC', 'West');
+
+INSERT INTO teams (created_at, updated_at, name)
+SELECT now(), now(), league_teams.name
+FROM league_teams
+WHERE NOT EXISTS (SELECT 1 FROM teams WHERE teams.name = league_teams.name);
+
+UPDATE teams
+SET division_id = divisions.id,
+    updated_at  = now()
+FROM league_teams
+JOIN conferences ON conferences.name = league_teams.conference
+JOIN divisions ON divisions.conference_id = conferences.id AND divisions.name = league_teams.division
+WHERE teams.name = league_teams.name;
+
+DROP TABLE league_teams;
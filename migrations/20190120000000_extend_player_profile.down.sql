@@ -0,0 +1,11 @@
+DROP INDEX idx_players_team_id_jersey_number;
+
+ALTER TABLE players
+    DROP COLUMN position,
+    DROP COLUMN jersey_number,
+    DROP COLUMN height,
+    DROP COLUMN weight,
+    DROP COLUMN birth_date,
+    DROP COLUMN college,
+    DROP COLUMN experience,
+    DROP COLUMN status;
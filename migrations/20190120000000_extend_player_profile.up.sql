@@ -0,0 +1,13 @@
+ALTER TABLE players
+    ADD COLUMN position      VARCHAR(2)   NOT NULL DEFAULT '',
+    ADD COLUMN jersey_number INTEGER CHECK (jersey_number BETWEEN 0 AND 99),
+    ADD COLUMN height        INTEGER      NOT NULL DEFAULT 0,
+    ADD COLUMN weight        INTEGER      NOT NULL DEFAULT 0,
+    ADD COLUMN birth_date    DATE,
+    ADD COLUMN college       VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN experience    INTEGER      NOT NULL DEFAULT 0,
+    ADD COLUMN status        VARCHAR(32)  NOT NULL DEFAULT 'active';
+
+-- Jersey numbers are unique only within the active roster of the team
+CREATE UNIQUE INDEX idx_players_team_id_jersey_number ON players (team_id, jersey_number)
+    WHERE status = 'active' AND jersey_number IS NOT NULL;
@@ -0,0 +1 @@
+DROP TABLE stat_lines;
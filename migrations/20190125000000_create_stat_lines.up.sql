@@ -0,0 +1,27 @@
+CREATE TABLE stat_lines (
+    id                      SERIAL PRIMARY KEY,
+    created_at              TIMESTAMP WITH TIME ZONE,
+    updated_at              TIMESTAMP WITH TIME ZONE,
+    player_id               INTEGER NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    game_id                 INTEGER NOT NULL REFERENCES games (id) ON DELETE CASCADE,
+    team_id                 INTEGER NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    pass_attempts           INTEGER NOT NULL DEFAULT 0,
+    pass_completions        INTEGER NOT NULL DEFAULT 0,
+    passing_yards           INTEGER NOT NULL DEFAULT 0,
+    passing_touchdowns      INTEGER NOT NULL DEFAULT 0,
+    passing_interceptions   INTEGER NOT NULL DEFAULT 0,
+    rush_attempts           INTEGER NOT NULL DEFAULT 0,
+    rushing_yards           INTEGER NOT NULL DEFAULT 0,
+    rushing_touchdowns      INTEGER NOT NULL DEFAULT 0,
+    targets                 INTEGER NOT NULL DEFAULT 0,
+    receptions              INTEGER NOT NULL DEFAULT 0,
+    receiving_yards         INTEGER NOT NULL DEFAULT 0,
+    receiving_touchdowns    INTEGER NOT NULL DEFAULT 0,
+    tackles                 INTEGER NOT NULL DEFAULT 0,
+    sacks                   NUMERIC(4, 1) NOT NULL DEFAULT 0,
+    defensive_interceptions INTEGER NOT NULL DEFAULT 0,
+    forced_fumbles          INTEGER NOT NULL DEFAULT 0,
+    UNIQUE (player_id, game_id)
+);
+
+CREATE INDEX idx_stat_lines_game_id ON stat_lines (game_id);
@@ -0,0 +1,14 @@
+CREATE TABLE transactions (
+    id           SERIAL PRIMARY KEY,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+    type         VARCHAR(32)  NOT NULL,
+    player_id    INTEGER      NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    from_team_id INTEGER REFERENCES teams (id) ON DELETE SET NULL,
+    to_team_id   INTEGER REFERENCES teams (id) ON DELETE SET NULL,
+    status       VARCHAR(32)  NOT NULL,
+    note         VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_transactions_player_id ON transactions (player_id);
+CREATE INDEX idx_transactions_from_team_id ON transactions (from_team_id);
+CREATE INDEX idx_transactions_to_team_id ON transactions (to_team_id);
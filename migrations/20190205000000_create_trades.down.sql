@@ -0,0 +1,3 @@
+ALTER TABLE transactions DROP COLUMN trade_id;
+
+DROP TABLE trades;
@@ -0,0 +1,9 @@
+CREATE TABLE trades (
+    id         SERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    note       VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+ALTER TABLE transactions ADD COLUMN trade_id INTEGER REFERENCES trades (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_transactions_trade_id ON transactions (trade_id);
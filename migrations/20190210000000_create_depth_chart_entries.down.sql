@@ -0,0 +1 @@
+DROP TABLE depth_chart_entries;
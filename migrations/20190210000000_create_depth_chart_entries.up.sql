@@ -0,0 +1,10 @@
+CREATE TABLE depth_chart_entries (
+    team_id   INTEGER     NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    position  VARCHAR(8)  NOT NULL,
+    depth     INTEGER     NOT NULL CHECK (depth > 0),
+    player_id INTEGER     NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    PRIMARY KEY (team_id, position, depth),
+    UNIQUE (team_id, position, player_id)
+);
+
+CREATE INDEX idx_depth_chart_entries_player_id ON depth_chart_entries (player_id);
@@ -0,0 +1,15 @@
+CREATE TABLE injuries (
+    id              SERIAL PRIMARY KEY,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    player_id       INTEGER      NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    team_id         INTEGER REFERENCES teams (id) ON DELETE SET NULL,
+    body_part       VARCHAR(255) NOT NULL,
+    game_status     VARCHAR(32)  NOT NULL,
+    practice_status VARCHAR(32)  NOT NULL,
+    reported_on     DATE         NOT NULL,
+    resolved_on     DATE CHECK (resolved_on >= reported_on)
+);
+
+CREATE INDEX idx_injuries_player_id ON injuries (player_id);
+CREATE INDEX idx_injuries_team_id ON injuries (team_id) WHERE resolved_on IS NULL;
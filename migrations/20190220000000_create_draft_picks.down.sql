@@ -0,0 +1,3 @@
+DROP TABLE pick_transfers;
+
+DROP TABLE draft_picks;
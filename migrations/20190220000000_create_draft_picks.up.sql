@@ -0,0 +1,26 @@
+CREATE TABLE draft_picks (
+    id               SERIAL PRIMARY KEY,
+    created_at       TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at       TIMESTAMP WITH TIME ZONE NOT NULL,
+    season_id        INTEGER NOT NULL REFERENCES seasons (id) ON DELETE CASCADE,
+    round            INTEGER NOT NULL CHECK (round > 0),
+    overall          INTEGER NOT NULL CHECK (overall > 0),
+    original_team_id INTEGER NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    owner_team_id    INTEGER NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    player_id        INTEGER UNIQUE REFERENCES players (id) ON DELETE SET NULL,
+    selected_at      TIMESTAMP WITH TIME ZONE,
+    UNIQUE (season_id, overall)
+);
+
+CREATE INDEX idx_draft_picks_owner_team_id ON draft_picks (owner_team_id);
+
+CREATE TABLE pick_transfers (
+    id            SERIAL PRIMARY KEY,
+    created_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+    trade_id      INTEGER NOT NULL REFERENCES trades (id) ON DELETE CASCADE,
+    draft_pick_id INTEGER NOT NULL REFERENCES draft_picks (id) ON DELETE CASCADE,
+    from_team_id  INTEGER NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    to_team_id    INTEGER NOT NULL REFERENCES teams (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_pick_transfers_trade_id ON pick_transfers (trade_id);
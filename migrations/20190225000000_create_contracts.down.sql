@@ -0,0 +1,3 @@
+DROP TABLE contract_years;
+
+DROP TABLE contracts;
@@ -0,0 +1,23 @@
+CREATE TABLE contracts (
+    id                SERIAL PRIMARY KEY,
+    created_at        TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at        TIMESTAMP WITH TIME ZONE NOT NULL,
+    player_id         INTEGER NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    team_id           INTEGER NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    signing_bonus     BIGINT  NOT NULL DEFAULT 0 CHECK (signing_bonus >= 0),
+    terminated_season INTEGER
+);
+
+CREATE INDEX idx_contracts_player_id ON contracts (player_id);
+CREATE INDEX idx_contracts_team_id ON contracts (team_id);
+
+CREATE TABLE contract_years (
+    id          SERIAL PRIMARY KEY,
+    contract_id INTEGER NOT NULL REFERENCES contracts (id) ON DELETE CASCADE,
+    season      INTEGER NOT NULL,
+    base_salary BIGINT  NOT NULL CHECK (base_salary >= 0),
+    guaranteed  BIGINT  NOT NULL DEFAULT 0 CHECK (guaranteed BETWEEN 0 AND base_salary),
+    UNIQUE (contract_id, season)
+);
+
+CREATE INDEX idx_contract_years_season ON contract_years (season);
@@ -0,0 +1,5 @@
+DROP TABLE refresh_tokens;
+
+DROP TABLE api_keys;
+
+DROP TABLE users;
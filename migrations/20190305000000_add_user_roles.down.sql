@@ -0,0 +1,4 @@
+DROP TABLE user_teams;
+
+ALTER TABLE users
+    DROP COLUMN role;
@@ -0,0 +1,10 @@
+ALTER TABLE users
+    ADD COLUMN role VARCHAR(16) NOT NULL DEFAULT 'viewer' CHECK (role IN ('admin', 'team_manager', 'viewer'));
+
+CREATE TABLE user_teams (
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    team_id INTEGER NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    PRIMARY KEY (user_id, team_id)
+);
+
+CREATE INDEX idx_user_teams_team_id ON user_teams (team_id);
@@ -0,0 +1,7 @@
+ALTER TABLE players
+    DROP CONSTRAINT players_team_id_fkey,
+    ADD CONSTRAINT players_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE transactions
+    DROP CONSTRAINT transactions_player_id_fkey,
+    ADD CONSTRAINT transactions_player_id_fkey FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE CASCADE;
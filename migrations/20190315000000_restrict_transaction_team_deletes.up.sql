@@ -0,0 +1,5 @@
+ALTER TABLE transactions
+    DROP CONSTRAINT transactions_from_team_id_fkey,
+    ADD CONSTRAINT transactions_from_team_id_fkey FOREIGN KEY (from_team_id) REFERENCES teams (id) ON DELETE RESTRICT,
+    DROP CONSTRAINT transactions_to_team_id_fkey,
+    ADD CONSTRAINT transactions_to_team_id_fkey FOREIGN KEY (to_team_id) REFERENCES teams (id) ON DELETE RESTRICT;
@@ -0,0 +1,2 @@
+ALTER TABLE contracts
+    ADD COLUMN traded BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+type Conference struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Name      string     `gorm:"unique_index"`
+	Divisions []Division `gorm:"foreignkey:ConferenceID"`
+}
@@ -0,0 +1,99 @@
+package models
+
+import "time"
+
+// Contract of the player with the team, all amounts are in dollars
+type Contract struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	PlayerID     int
+	Player       Player
+	TeamID       int
+	SigningBonus int64
+	// Set when the player is released or traded, remaining bonus and guarantees accelerate into this season
+	TerminatedSeason *int
+	// Contract was terminated by a trade, the new team takes over guaranteed salaries and only the bonus
+	// accelerates
+	Traded bool
+	Years  []ContractYear `gorm:"foreignkey:ContractID"`
+}
+
+// Salary of a single season of the contract
+type ContractYear struct {
+	ID         uint `gorm:"primary_key"`
+	ContractID int
+	Season     int
+	BaseSalary int64
+	// Part of the base salary paid even when the player is released
+	Guaranteed int64
+}
+
+// Signing bonus is spread evenly over at most this many seasons
+const MaxBonusProration = 5
+
+// Part of the signing bonus charged in the season, remainder of the division is charged in the first one
+func (c Contract) Proration(season int) int64 {
+	if len(c.Years) == 0 {
+		return 0
+	}
+
+	seasons := len(c.Years)
+
+	if seasons > MaxBonusProration {
+		seasons = MaxBonusProration
+	}
+
+	index := season - c.Years[0].Season
+
+	if index < 0 || index >= seasons {
+		return 0
+	}
+
+	charge := c.SigningBonus / int64(seasons)
+
+	if index == 0 {
+		charge += c.SigningBonus % int64(seasons)
+	}
+
+	return charge
+}
+
+// Base salary with prorated bonus, terminated contracts have no cap hit from the termination on
+func (c Contract) CapHit(season int) int64 {
+	if c.TerminatedSeason != nil && season >= *c.TerminatedSeason {
+		return 0
+	}
+
+	for _, year := range c.Years {
+		if year.Season == season {
+			return year.BaseSalary + c.Proration(season)
+		}
+	}
+
+	return 0
+}
+
+// Remaining bonus and guaranteed salaries charged in the season the contract was terminated
+func (c Contract) DeadMoney(season int) int64 {
+	if c.TerminatedSeason == nil || season != *c.TerminatedSeason {
+		return 0
+	}
+
+	var dead int64
+
+	for _, year := range c.Years {
+		if year.Season < season {
+			continue
+		}
+
+		dead += c.Proration(year.Season)
+
+		if !c.Traded {
+			dead += year.Guaranteed
+		}
+	}
+
+	return dead
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestContract_CapHit(t *testing.T) {
+	contract := Contract{
+		SigningBonus: 10000000,
+		Years: []ContractYear{
+			{Season: 2019, BaseSalary: 1000000, Guaranteed: 1000000},
+			{Season: 2020, BaseSalary: 2000000, Guaranteed: 1000000},
+			{Season: 2021, BaseSalary: 3000000},
+		},
+	}
+
+	assert.Equal(t, int64(3333334), contract.Proration(2019))
+	assert.Equal(t, int64(3333333), contract.Proration(2021))
+	assert.Equal(t, int64(4333334), contract.CapHit(2019))
+	assert.Equal(t, int64(0), contract.CapHit(2022))
+	assert.Equal(t, int64(0), contract.DeadMoney(2020))
+
+	terminated := 2020
+	contract.TerminatedSeason = &terminated
+
+	assert.Equal(t, int64(4333334), contract.CapHit(2019))
+	assert.Equal(t, int64(0), contract.CapHit(2020))
+	assert.Equal(t, int64(3333333+1000000+3333333), contract.DeadMoney(2020))
+	assert.Equal(t, int64(0), contract.DeadMoney(2021))
+
+	contract.Traded = true
+
+	assert.Equal(t, int64(3333333+3333333), contract.DeadMoney(2020))
+}
+
+func TestContract_ProrationLimit(t *testing.T) {
+	contract := Contract{SigningBonus: 5000000}
+
+	for season := 2019; season < 2026; season++ {
+		contract.Years = append(contract.Years, ContractYear{Season: season})
+	}
+
+	assert.Equal(t, int64(1000000), contract.Proration(2023))
+	assert.Equal(t, int64(0), contract.Proration(2024))
+}
@@ -0,0 +1,23 @@
+package models
+
+import "github.com/jinzhu/gorm"
+
+// DepthChartEntry places the player at the position of the team's depth chart, starters have the lowest depth
+type DepthChartEntry struct {
+	TeamID   int    `gorm:"primary_key;auto_increment:false"`
+	Position string `gorm:"primary_key"`
+	Depth    int    `gorm:"primary_key;auto_increment:false"`
+	PlayerID int
+	Player   Player
+}
+
+// Players who left the team are removed from its depth chart on any update of the player
+func (p *Player) AfterUpdate(tx *gorm.DB) error {
+	chart := tx.Where("player_id = ?", p.ID)
+
+	if p.TeamID != nil {
+		chart = chart.Where("team_id <> ?", *p.TeamID)
+	}
+
+	return chart.Delete(&DepthChartEntry{}).Error
+}
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+type Division struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	ConferenceID int
+	Conference   Conference `gorm:"foreignkey:ConferenceID" sql:"type:int REFERENCES conferences(id)"`
+	Name         string
+	Teams        []Team `gorm:"foreignkey:DivisionID"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// DraftPick is a selection in the draft of the season, it can be traded until the player is selected
+type DraftPick struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	SeasonID int
+	Season   Season
+	Round    int
+	// Number of the pick in the whole draft, starting at 1
+	Overall        int
+	OriginalTeamID int
+	OwnerTeamID    int
+	// Set when the pick is made
+	PlayerID   *int
+	Player     Player
+	SelectedAt *time.Time
+}
+
+// PickTransfer records the change of pick owner by a trade
+type PickTransfer struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+
+	TradeID     int
+	DraftPickID int
+	FromTeamID  int
+	ToTeamID    int
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+)
+
+type CredentialsDTO struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type RefreshDTO struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type TokensDTO struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+type APIKeyDTO struct {
+	ID uint `json:"id"`
+
+	Name   string `json:"name"`
+	Prefix string `json:"prefix"`
+	// Returned only when the key is created
+	Key string `json:"key,omitempty"`
+
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"time"
+)
+
+type ConferenceDTO struct {
+	ID uint `json:"id"`
+
+	Name string `json:"name"`
+
+	// Set only when divisions are loaded
+	Divisions []DivisionDTO `json:"divisions,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type DivisionDTO struct {
+	ID uint `json:"id"`
+
+	ConferenceID int    `json:"conference_id"`
+	Name         string `json:"name"`
+
+	// Name of the conference, set only when conference is loaded
+	Conference string `json:"conference,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"time"
+)
+
+type ContractYearDTO struct {
+	Season     int   `json:"season"`
+	BaseSalary int64 `json:"base_salary"`
+	Guaranteed int64 `json:"guaranteed"`
+	// Base salary with prorated signing bonus
+	CapHit int64 `json:"cap_hit"`
+}
+
+type ContractDTO struct {
+	ID uint `json:"id"`
+
+	PlayerID     int               `json:"player_id"`
+	TeamID       int               `json:"team_id"`
+	SigningBonus int64             `json:"signing_bonus"`
+	Years        []ContractYearDTO `json:"years"`
+
+	TerminatedSeason *int `json:"terminated_season"`
+	Traded           bool `json:"traded"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Cap charge of a single contract in the season
+type CapChargeDTO struct {
+	ContractID int    `json:"contract_id"`
+	PlayerID   int    `json:"player_id"`
+	Player     string `json:"player"`
+	CapHit     int64  `json:"cap_hit"`
+	DeadMoney  int64  `json:"dead_money"`
+}
+
+type TeamCapDTO struct {
+	TeamID    int   `json:"team_id"`
+	Season    int   `json:"season"`
+	SalaryCap int64 `json:"salary_cap"`
+	Committed int64 `json:"committed"`
+	DeadMoney int64 `json:"dead_money"`
+	Space     int64 `json:"space"`
+
+	Charges []CapChargeDTO `json:"charges"`
+}
@@ -0,0 +1,16 @@
+package dto
+
+type DepthChartDTO struct {
+	TeamID int `json:"team_id"`
+
+	Positions []DepthChartPositionDTO `json:"positions"`
+}
+
+// Players of the position ordered from starter to the last backup
+type DepthChartPositionDTO struct {
+	Position  string `json:"position"`
+	PlayerIDs []int  `json:"player_ids"`
+
+	// Set only when reading the depth chart
+	Players []PlayerDTO `json:"players,omitempty"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+)
+
+type DraftPickDTO struct {
+	ID uint `json:"id"`
+
+	Season         int `json:"season"`
+	Round          int `json:"round"`
+	Overall        int `json:"overall"`
+	OriginalTeamID int `json:"original_team_id"`
+	OwnerTeamID    int `json:"owner_team_id"`
+
+	PlayerID   *int       `json:"player_id"`
+	SelectedAt *time.Time `json:"selected_at"`
+
+	// Set only when the pick is made
+	Player *PlayerDTO `json:"player,omitempty"`
+}
+
+// Teams in order of selection, the same order is used in every round
+type DraftOrderDTO struct {
+	TeamIDs []int `json:"team_ids"`
+	Rounds  int   `json:"rounds"`
+}
+
+// Player selected with the pick on the clock
+type DraftSelectionDTO struct {
+	Overall int       `json:"overall"`
+	Player  PlayerDTO `json:"player"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"time"
+)
+
+type GameDTO struct {
+	ID uint `json:"id"`
+
+	Season     int       `json:"season"`
+	Week       int       `json:"week"`
+	HomeTeamID int       `json:"home_team_id"`
+	AwayTeamID int       `json:"away_team_id"`
+	KickoffAt  time.Time `json:"kickoff_at"`
+	Venue      string    `json:"venue"`
+	Status     string    `json:"status"`
+	HomeScore  int       `json:"home_score"`
+	AwayScore  int       `json:"away_score"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Score and status of the game reported during or after it
+type GameResultDTO struct {
+	Status    string `json:"status"`
+	HomeScore int    `json:"home_score"`
+	AwayScore int    `json:"away_score"`
+}
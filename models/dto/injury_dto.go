@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"time"
+)
+
+type InjuryDTO struct {
+	ID uint `json:"id"`
+
+	PlayerID int  `json:"player_id"`
+	TeamID   *int `json:"team_id"`
+
+	BodyPart       string `json:"body_part"`
+	GameStatus     string `json:"game_status"`
+	PracticeStatus string `json:"practice_status"`
+	// Formatted as YYYY-MM-DD
+	ReportedOn string  `json:"reported_on"`
+	ResolvedOn *string `json:"resolved_on"`
+
+	// Set only when player is included
+	Player *PlayerDTO `json:"player,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -9,7 +9,20 @@ type PlayerDTO struct {
 
 	Name   string `json:"name"`
 	Avatar string `json:"avatar"`
-	TeamID int    `json:"team_id"`
+	TeamID *int   `json:"team_id"`
+
+	Position     string `json:"position"`
+	JerseyNumber *int   `json:"jersey_number"`
+	Height       int    `json:"height"`
+	Weight       int    `json:"weight"`
+	// Formatted as YYYY-MM-DD
+	BirthDate  string `json:"birth_date"`
+	College    string `json:"college"`
+	Experience int    `json:"experience"`
+	Status     string `json:"status"`
+
+	// Set only when team is included
+	Team *TeamDTO `json:"team,omitempty"`
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
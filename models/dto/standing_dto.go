@@ -0,0 +1,28 @@
+package dto
+
+type StandingDTO struct {
+	Rank   int    `json:"rank"`
+	TeamID int    `json:"team_id"`
+	Team   string `json:"team"`
+
+	Wins          int     `json:"wins"`
+	Losses        int     `json:"losses"`
+	Ties          int     `json:"ties"`
+	WinPct        float64 `json:"win_pct"`
+	PointsFor     int     `json:"points_for"`
+	PointsAgainst int     `json:"points_against"`
+	PointDiff     int     `json:"point_diff"`
+
+	// Records formatted as W-L-T
+	Home       string `json:"home"`
+	Away       string `json:"away"`
+	Division   string `json:"division"`
+	Conference string `json:"conference"`
+	Streak     string `json:"streak"`
+}
+
+type StandingsGroupDTO struct {
+	Conference string        `json:"conference,omitempty"`
+	Division   string        `json:"division,omitempty"`
+	Teams      []StandingDTO `json:"teams"`
+}
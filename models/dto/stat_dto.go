@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"time"
+)
+
+type StatsDTO struct {
+	PassAttempts           int     `json:"pass_attempts"`
+	PassCompletions        int     `json:"pass_completions"`
+	PassingYards           int     `json:"passing_yards"`
+	PassingTouchdowns      int     `json:"passing_touchdowns"`
+	PassingInterceptions   int     `json:"passing_interceptions"`
+	RushAttempts           int     `json:"rush_attempts"`
+	RushingYards           int     `json:"rushing_yards"`
+	RushingTouchdowns      int     `json:"rushing_touchdowns"`
+	Targets                int     `json:"targets"`
+	Receptions             int     `json:"receptions"`
+	ReceivingYards         int     `json:"receiving_yards"`
+	ReceivingTouchdowns    int     `json:"receiving_touchdowns"`
+	Tackles                int     `json:"tackles"`
+	Sacks                  float64 `json:"sacks"`
+	DefensiveInterceptions int     `json:"defensive_interceptions"`
+	ForcedFumbles          int     `json:"forced_fumbles"`
+}
+
+type StatLineDTO struct {
+	ID uint `json:"id"`
+
+	PlayerID int `json:"player_id"`
+	GameID   int `json:"game_id"`
+	// Defaults to the current team of the player
+	TeamID int `json:"team_id"`
+
+	StatsDTO
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type StatTotalsDTO struct {
+	Season int `json:"season"`
+	// Set only when split by week
+	Week  int `json:"week,omitempty"`
+	Games int `json:"games"`
+
+	StatsDTO
+}
+
+type LeaderDTO struct {
+	Rank     int     `json:"rank"`
+	PlayerID int     `json:"player_id"`
+	Player   string  `json:"player"`
+	Games    int     `json:"games"`
+	Value    float64 `json:"value"`
+}
@@ -7,9 +7,20 @@ import (
 type TeamDTO struct {
 	ID uint `json:"id"`
 
-	Name string `json:"name"`
-	Logo string `json:"logo"`
+	Name       string `json:"name"`
+	Logo       string `json:"logo"`
+	DivisionID *int   `json:"division_id"`
+
+	// Set only when division is included
+	Division *DivisionDTO `json:"division,omitempty"`
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+// Team with preloaded roster
+type TeamRosterDTO struct {
+	TeamDTO
+
+	Players []PlayerDTO `json:"players"`
+}
@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"time"
+)
+
+type TransactionDTO struct {
+	ID uint `json:"id"`
+
+	Type     string `json:"type"`
+	PlayerID int    `json:"player_id"`
+	// Team the player leaves, set by the transaction
+	FromTeamID *int `json:"from_team_id"`
+	// Team the player joins, required for signings and trades
+	ToTeamID *int   `json:"to_team_id"`
+	Status   string `json:"status"`
+	Note     string `json:"note"`
+	// Set for players moved by a multi-player trade
+	TradeID *int `json:"trade_id,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type TradeItemDTO struct {
+	PlayerID   int `json:"player_id"`
+	FromTeamID int `json:"from_team_id"`
+	ToTeamID   int `json:"to_team_id"`
+}
+
+type TradePickDTO struct {
+	PickID     int `json:"pick_id"`
+	FromTeamID int `json:"from_team_id"`
+	ToTeamID   int `json:"to_team_id"`
+}
+
+type TradeDTO struct {
+	ID uint `json:"id"`
+
+	Items []TradeItemDTO `json:"items"`
+	Picks []TradePickDTO `json:"picks"`
+	Note  string         `json:"note"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -1,39 +1,541 @@
 package models
 
-import "github.com/logansua/nfl_app/models/dto"
+import (
+	"github.com/logansua/nfl_app/models/dto"
+	"time"
+)
 
 func NewTeamDTO(data Team) dto.TeamDTO {
-	return dto.TeamDTO{
+	team := dto.TeamDTO{
+		ID:         data.ID,
+		Name:       data.Name,
+		Logo:       data.Logo,
+		DivisionID: data.DivisionID,
+		CreatedAt:  data.CreatedAt,
+		UpdatedAt:  data.UpdatedAt,
+	}
+
+	// Division is loaded only when it was preloaded
+	if data.Division.ID != 0 {
+		division := NewDivisionDTO(data.Division)
+
+		team.Division = &division
+	}
+
+	return team
+}
+
+func NewPlayerDTO(data Player) dto.PlayerDTO {
+	player := dto.PlayerDTO{
+		ID:           data.ID,
+		Name:         data.Name,
+		Avatar:       data.Avatar,
+		TeamID:       data.TeamID,
+		Position:     data.Position,
+		JerseyNumber: data.JerseyNumber,
+		Height:       data.Height,
+		Weight:       data.Weight,
+		College:      data.College,
+		Experience:   data.Experience,
+		Status:       data.Status,
+		CreatedAt:    data.CreatedAt,
+		UpdatedAt:    data.UpdatedAt,
+	}
+
+	if data.BirthDate != nil {
+		player.BirthDate = data.BirthDate.Format(DateFormat)
+	}
+
+	// Team is loaded only when it was preloaded
+	if data.Team.ID != 0 {
+		team := NewTeamDTO(data.Team)
+
+		player.Team = &team
+	}
+
+	return player
+}
+
+func NewPlayerModel(data *dto.PlayerDTO) Player {
+	player := Player{
+		Name:         data.Name,
+		Avatar:       data.Avatar,
+		TeamID:       data.TeamID,
+		Position:     data.Position,
+		JerseyNumber: data.JerseyNumber,
+		Height:       data.Height,
+		Weight:       data.Weight,
+		College:      data.College,
+		Experience:   data.Experience,
+		Status:       data.Status,
+	}
+
+	// Birth date is validated before the model is created, malformed value is dropped
+	if birthDate, err := time.Parse(DateFormat, data.BirthDate); err == nil {
+		player.BirthDate = &birthDate
+	}
+
+	return player
+}
+
+func NewTeamModel(data *dto.TeamDTO) Team {
+	return Team{
+		Name:       data.Name,
+		Logo:       data.Logo,
+		DivisionID: data.DivisionID,
+	}
+}
+
+func NewTeamRosterDTO(data Team) dto.TeamRosterDTO {
+	players := make([]dto.PlayerDTO, len(data.Players))
+
+	for key, value := range data.Players {
+		players[key] = NewPlayerDTO(value)
+	}
+
+	return dto.TeamRosterDTO{
+		TeamDTO: NewTeamDTO(data),
+		Players: players,
+	}
+}
+
+func NewGameDTO(data Game) dto.GameDTO {
+	return dto.GameDTO{
+		ID:         data.ID,
+		Season:     data.Season.Year,
+		Week:       data.Week,
+		HomeTeamID: data.HomeTeamID,
+		AwayTeamID: data.AwayTeamID,
+		KickoffAt:  data.KickoffAt,
+		Venue:      data.Venue,
+		Status:     data.Status,
+		HomeScore:  data.HomeScore,
+		AwayScore:  data.AwayScore,
+		CreatedAt:  data.CreatedAt,
+		UpdatedAt:  data.UpdatedAt,
+	}
+}
+
+func NewGameModel(data *dto.GameDTO) Game {
+	return Game{
+		Week:       data.Week,
+		HomeTeamID: data.HomeTeamID,
+		AwayTeamID: data.AwayTeamID,
+		KickoffAt:  data.KickoffAt,
+		Venue:      data.Venue,
+		Status:     data.Status,
+		HomeScore:  data.HomeScore,
+		AwayScore:  data.AwayScore,
+	}
+}
+
+func NewConferenceDTO(data Conference) dto.ConferenceDTO {
+	conference := dto.ConferenceDTO{
 		ID:        data.ID,
 		Name:      data.Name,
-		Logo:      data.Logo,
 		CreatedAt: data.CreatedAt,
 		UpdatedAt: data.UpdatedAt,
 	}
+
+	// Divisions are listed only when they were preloaded
+	if data.Divisions != nil {
+		conference.Divisions = make([]dto.DivisionDTO, len(data.Divisions))
+
+		for key, value := range data.Divisions {
+			conference.Divisions[key] = NewDivisionDTO(value)
+		}
+	}
+
+	return conference
+}
+
+func NewConferenceModel(data *dto.ConferenceDTO) Conference {
+	return Conference{
+		Name: data.Name,
+	}
 }
 
-func NewPlayerDTO(data Player) dto.PlayerDTO {
-	return dto.PlayerDTO{
+func NewDivisionDTO(data Division) dto.DivisionDTO {
+	division := dto.DivisionDTO{
+		ID:           data.ID,
+		ConferenceID: data.ConferenceID,
+		Name:         data.Name,
+		CreatedAt:    data.CreatedAt,
+		UpdatedAt:    data.UpdatedAt,
+	}
+
+	// Conference is loaded only when it was preloaded
+	if data.Conference.ID != 0 {
+		division.Conference = data.Conference.Name
+	}
+
+	return division
+}
+
+func NewDivisionModel(data *dto.DivisionDTO) Division {
+	return Division{
+		ConferenceID: data.ConferenceID,
+		Name:         data.Name,
+	}
+}
+
+func NewStatsDTO(data Stats) dto.StatsDTO {
+	return dto.StatsDTO{
+		PassAttempts:           data.PassAttempts,
+		PassCompletions:        data.PassCompletions,
+		PassingYards:           data.PassingYards,
+		PassingTouchdowns:      data.PassingTouchdowns,
+		PassingInterceptions:   data.PassingInterceptions,
+		RushAttempts:           data.RushAttempts,
+		RushingYards:           data.RushingYards,
+		RushingTouchdowns:      data.RushingTouchdowns,
+		Targets:                data.Targets,
+		Receptions:             data.Receptions,
+		ReceivingYards:         data.ReceivingYards,
+		ReceivingTouchdowns:    data.ReceivingTouchdowns,
+		Tackles:                data.Tackles,
+		Sacks:                  data.Sacks,
+		DefensiveInterceptions: data.DefensiveInterceptions,
+		ForcedFumbles:          data.ForcedFumbles,
+	}
+}
+
+func NewStatsModel(data *dto.StatsDTO) Stats {
+	return Stats{
+		PassAttempts:           data.PassAttempts,
+		PassCompletions:        data.PassCompletions,
+		PassingYards:           data.PassingYards,
+		PassingTouchdowns:      data.PassingTouchdowns,
+		PassingInterceptions:   data.PassingInterceptions,
+		RushAttempts:           data.RushAttempts,
+		RushingYards:           data.RushingYards,
+		RushingTouchdowns:      data.RushingTouchdowns,
+		Targets:                data.Targets,
+		Receptions:             data.Receptions,
+		ReceivingYards:         data.ReceivingYards,
+		ReceivingTouchdowns:    data.ReceivingTouchdowns,
+		Tackles:                data.Tackles,
+		Sacks:                  data.Sacks,
+		DefensiveInterceptions: data.DefensiveInterceptions,
+		ForcedFumbles:          data.ForcedFumbles,
+	}
+}
+
+func NewStatLineDTO(data StatLine) dto.StatLineDTO {
+	return dto.StatLineDTO{
 		ID:        data.ID,
-		Name:      data.Name,
-		Avatar:    data.Avatar,
+		PlayerID:  data.PlayerID,
+		GameID:    data.GameID,
 		TeamID:    data.TeamID,
+		StatsDTO:  NewStatsDTO(data.Stats),
 		CreatedAt: data.CreatedAt,
 		UpdatedAt: data.UpdatedAt,
 	}
 }
 
-func NewPlayerModel(data *dto.PlayerDTO) Player {
-	return Player{
-		Name:   data.Name,
-		Avatar: data.Avatar,
-		TeamID: data.TeamID,
+func NewStatLineModel(data *dto.StatLineDTO) StatLine {
+	return StatLine{
+		PlayerID: data.PlayerID,
+		GameID:   data.GameID,
+		TeamID:   data.TeamID,
+		Stats:    NewStatsModel(&data.StatsDTO),
 	}
 }
 
-func NewTeamModel(data *dto.TeamDTO) Team {
-	return Team{
-		Name: data.Name,
-		Logo: data.Logo,
+func NewStatTotalsDTO(data StatTotals) dto.StatTotalsDTO {
+	return dto.StatTotalsDTO{
+		Season:   data.Season,
+		Week:     data.Week,
+		Games:    data.Games,
+		StatsDTO: NewStatsDTO(data.Stats),
+	}
+}
+
+func NewLeaderDTO(data Leader) dto.LeaderDTO {
+	return dto.LeaderDTO{
+		Rank:     data.Rank,
+		PlayerID: data.PlayerID,
+		Player:   data.Player,
+		Games:    data.Games,
+		Value:    data.Value,
+	}
+}
+
+func NewTransactionDTO(data Transaction) dto.TransactionDTO {
+	return dto.TransactionDTO{
+		ID:         data.ID,
+		Type:       data.Type,
+		PlayerID:   data.PlayerID,
+		FromTeamID: data.FromTeamID,
+		ToTeamID:   data.ToTeamID,
+		Status:     data.Status,
+		Note:       data.Note,
+		TradeID:    data.TradeID,
+		CreatedAt:  data.CreatedAt,
+	}
+}
+
+func NewTransactionModel(data *dto.TransactionDTO) Transaction {
+	return Transaction{
+		Type:     data.Type,
+		PlayerID: data.PlayerID,
+		ToTeamID: data.ToTeamID,
+		Status:   data.Status,
+		Note:     data.Note,
+	}
+}
+
+func NewTradeDTO(data Trade) dto.TradeDTO {
+	items := make([]dto.TradeItemDTO, len(data.Transactions))
+
+	for key, value := range data.Transactions {
+		items[key] = dto.TradeItemDTO{PlayerID: value.PlayerID}
+
+		if value.FromTeamID != nil {
+			items[key].FromTeamID = *value.FromTeamID
+		}
+
+		if value.ToTeamID != nil {
+			items[key].ToTeamID = *value.ToTeamID
+		}
+	}
+
+	picks := make([]dto.TradePickDTO, len(data.PickTransfers))
+
+	for key, value := range data.PickTransfers {
+		picks[key] = dto.TradePickDTO{
+			PickID:     value.DraftPickID,
+			FromTeamID: value.FromTeamID,
+			ToTeamID:   value.ToTeamID,
+		}
+	}
+
+	return dto.TradeDTO{
+		ID:        data.ID,
+		Items:     items,
+		Picks:     picks,
+		Note:      data.Note,
+		CreatedAt: data.CreatedAt,
+	}
+}
+
+// Trade of the players and picks, status of each player is kept when the trade is executed
+func NewTradeModel(data *dto.TradeDTO) Trade {
+	transactions := make([]Transaction, len(data.Items))
+
+	for key, value := range data.Items {
+		fromTeamID, toTeamID := value.FromTeamID, value.ToTeamID
+
+		transactions[key] = Transaction{
+			Type:       TransactionTrade,
+			PlayerID:   value.PlayerID,
+			FromTeamID: &fromTeamID,
+			ToTeamID:   &toTeamID,
+			Note:       data.Note,
+		}
+	}
+
+	transfers := make([]PickTransfer, len(data.Picks))
+
+	for key, value := range data.Picks {
+		transfers[key] = PickTransfer{
+			DraftPickID: value.PickID,
+			FromTeamID:  value.FromTeamID,
+			ToTeamID:    value.ToTeamID,
+		}
+	}
+
+	return Trade{
+		Note:          data.Note,
+		Transactions:  transactions,
+		PickTransfers: transfers,
+	}
+}
+
+// Group entries by position in order of Positions, entries must be sorted by depth
+func NewDepthChartDTO(teamID int, entries []DepthChartEntry) dto.DepthChartDTO {
+	chart := dto.DepthChartDTO{TeamID: teamID, Positions: []dto.DepthChartPositionDTO{}}
+
+	for _, position := range Positions {
+		slot := dto.DepthChartPositionDTO{Position: position}
+
+		for _, value := range entries {
+			if value.Position != position {
+				continue
+			}
+
+			slot.PlayerIDs = append(slot.PlayerIDs, value.PlayerID)
+			slot.Players = append(slot.Players, NewPlayerDTO(value.Player))
+		}
+
+		if len(slot.PlayerIDs) > 0 {
+			chart.Positions = append(chart.Positions, slot)
+		}
+	}
+
+	return chart
+}
+
+// Depth of each player follows the order of the list, starting at 1
+func NewDepthChartModel(data *dto.DepthChartDTO) []DepthChartEntry {
+	var entries []DepthChartEntry
+
+	for _, slot := range data.Positions {
+		for key, playerID := range slot.PlayerIDs {
+			entries = append(entries, DepthChartEntry{
+				TeamID:   data.TeamID,
+				Position: slot.Position,
+				Depth:    key + 1,
+				PlayerID: playerID,
+			})
+		}
+	}
+
+	return entries
+}
+
+func NewInjuryDTO(data Injury) dto.InjuryDTO {
+	injury := dto.InjuryDTO{
+		ID:             data.ID,
+		PlayerID:       data.PlayerID,
+		TeamID:         data.TeamID,
+		BodyPart:       data.BodyPart,
+		GameStatus:     data.GameStatus,
+		PracticeStatus: data.PracticeStatus,
+		ReportedOn:     data.ReportedOn.Format(DateFormat),
+		CreatedAt:      data.CreatedAt,
+		UpdatedAt:      data.UpdatedAt,
+	}
+
+	if data.ResolvedOn != nil {
+		resolvedOn := data.ResolvedOn.Format(DateFormat)
+
+		injury.ResolvedOn = &resolvedOn
+	}
+
+	// Player is loaded only when it was preloaded
+	if data.Player.ID != 0 {
+		player := NewPlayerDTO(data.Player)
+
+		injury.Player = &player
+	}
+
+	return injury
+}
+
+// Dates are validated before the model is created, malformed values are dropped
+func NewInjuryModel(data *dto.InjuryDTO) Injury {
+	injury := Injury{
+		PlayerID:       data.PlayerID,
+		BodyPart:       data.BodyPart,
+		GameStatus:     data.GameStatus,
+		PracticeStatus: data.PracticeStatus,
+	}
+
+	if reportedOn, err := time.Parse(DateFormat, data.ReportedOn); err == nil {
+		injury.ReportedOn = reportedOn
+	}
+
+	if data.ResolvedOn != nil {
+		if resolvedOn, err := time.Parse(DateFormat, *data.ResolvedOn); err == nil {
+			injury.ResolvedOn = &resolvedOn
+		}
+	}
+
+	return injury
+}
+
+func NewDraftPickDTO(data DraftPick) dto.DraftPickDTO {
+	pick := dto.DraftPickDTO{
+		ID:             data.ID,
+		Season:         data.Season.Year,
+		Round:          data.Round,
+		Overall:        data.Overall,
+		OriginalTeamID: data.OriginalTeamID,
+		OwnerTeamID:    data.OwnerTeamID,
+		PlayerID:       data.PlayerID,
+		SelectedAt:     data.SelectedAt,
+	}
+
+	// Player is loaded only when it was preloaded
+	if data.Player.ID != 0 {
+		player := NewPlayerDTO(data.Player)
+
+		pick.Player = &player
+	}
+
+	return pick
+}
+
+// Picks of all rounds, each team starts owning its own picks
+func NewDraftPickModels(seasonID int, data *dto.DraftOrderDTO) []DraftPick {
+	picks := make([]DraftPick, 0, data.Rounds*len(data.TeamIDs))
+
+	for round := 1; round <= data.Rounds; round++ {
+		for _, teamID := range data.TeamIDs {
+			picks = append(picks, DraftPick{
+				SeasonID:       seasonID,
+				Round:          round,
+				Overall:        len(picks) + 1,
+				OriginalTeamID: teamID,
+				OwnerTeamID:    teamID,
+			})
+		}
+	}
+
+	return picks
+}
+
+func NewContractDTO(data Contract) dto.ContractDTO {
+	years := make([]dto.ContractYearDTO, len(data.Years))
+
+	for key, value := range data.Years {
+		years[key] = dto.ContractYearDTO{
+			Season:     value.Season,
+			BaseSalary: value.BaseSalary,
+			Guaranteed: value.Guaranteed,
+			CapHit:     data.CapHit(value.Season),
+		}
+	}
+
+	return dto.ContractDTO{
+		ID:               data.ID,
+		PlayerID:         data.PlayerID,
+		TeamID:           data.TeamID,
+		SigningBonus:     data.SigningBonus,
+		Years:            years,
+		TerminatedSeason: data.TerminatedSeason,
+		Traded:           data.Traded,
+		CreatedAt:        data.CreatedAt,
+		UpdatedAt:        data.UpdatedAt,
+	}
+}
+
+func NewContractModel(data *dto.ContractDTO) Contract {
+	years := make([]ContractYear, len(data.Years))
+
+	for key, value := range data.Years {
+		years[key] = ContractYear{
+			Season:     value.Season,
+			BaseSalary: value.BaseSalary,
+			Guaranteed: value.Guaranteed,
+		}
+	}
+
+	return Contract{
+		PlayerID:     data.PlayerID,
+		TeamID:       data.TeamID,
+		SigningBonus: data.SigningBonus,
+		Years:        years,
+	}
+}
+
+func NewAPIKeyDTO(data APIKey) dto.APIKeyDTO {
+	return dto.APIKeyDTO{
+		ID:         data.ID,
+		Name:       data.Name,
+		Prefix:     data.Prefix,
+		CreatedAt:  data.CreatedAt,
+		LastUsedAt: data.LastUsedAt,
 	}
 }
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// Game statuses
+const (
+	GameScheduled  = "scheduled"
+	GameInProgress = "in_progress"
+	GameFinal      = "final"
+	GamePostponed  = "postponed"
+	GameCanceled   = "canceled"
+)
+
+var GameStatuses = []string{GameScheduled, GameInProgress, GameFinal, GamePostponed, GameCanceled}
+
+type Game struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	SeasonID   int
+	Season     Season `gorm:"foreignkey:SeasonID" sql:"type:int REFERENCES seasons(id)"`
+	Week       int
+	HomeTeamID int
+	HomeTeam   Team `gorm:"foreignkey:HomeTeamID" sql:"type:int REFERENCES teams(id)"`
+	AwayTeamID int
+	AwayTeam   Team `gorm:"foreignkey:AwayTeamID" sql:"type:int REFERENCES teams(id)"`
+	KickoffAt  time.Time
+	Venue      string
+	Status     string
+	HomeScore  int
+	AwayScore  int
+}
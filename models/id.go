@@ -0,0 +1,10 @@
+package models
+
+// SameID reports whether optional IDs, such as team of a free agent, are equal
+func SameID(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// Availability of injured players for the next game
+const (
+	InjuryQuestionable = "questionable"
+	InjuryDoubtful     = "doubtful"
+	InjuryOut          = "out"
+)
+
+var InjuryGameStatuses = []string{InjuryQuestionable, InjuryDoubtful, InjuryOut}
+
+// Practice participation of injured players
+const (
+	PracticeFull              = "full"
+	PracticeLimited           = "limited"
+	PracticeDidNotParticipate = "did_not_participate"
+)
+
+var PracticeStatuses = []string{PracticeFull, PracticeLimited, PracticeDidNotParticipate}
+
+type Injury struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	PlayerID int
+	Player   Player
+	// Team of the player when the injury was reported
+	TeamID *int
+
+	BodyPart       string
+	GameStatus     string
+	PracticeStatus string
+	ReportedOn     time.Time  `sql:"type:date"`
+	ResolvedOn     *time.Time `sql:"type:date"`
+}
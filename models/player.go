@@ -2,6 +2,41 @@ package models
 
 import "time"
 
+// Player positions
+const (
+	PositionQuarterback   = "QB"
+	PositionRunningBack   = "RB"
+	PositionWideReceiver  = "WR"
+	PositionTightEnd      = "TE"
+	PositionOffensiveLine = "OL"
+	PositionDefensiveLine = "DL"
+	PositionLinebacker    = "LB"
+	PositionCornerback    = "CB"
+	PositionSafety        = "S"
+	PositionKicker        = "K"
+	PositionPunter        = "P"
+	PositionLongSnapper   = "LS"
+)
+
+var Positions = []string{
+	PositionQuarterback, PositionRunningBack, PositionWideReceiver, PositionTightEnd,
+	PositionOffensiveLine, PositionDefensiveLine, PositionLinebacker, PositionCornerback,
+	PositionSafety, PositionKicker, PositionPunter, PositionLongSnapper,
+}
+
+// Roster statuses
+const (
+	PlayerActive         = "active"
+	PlayerInjuredReserve = "injured_reserve"
+	PlayerPracticeSquad  = "practice_squad"
+	PlayerSuspended      = "suspended"
+)
+
+var PlayerStatuses = []string{PlayerActive, PlayerInjuredReserve, PlayerPracticeSquad, PlayerSuspended}
+
+// Format of player birth date
+const DateFormat = "2006-01-02"
+
 type Player struct {
 	ID        uint `gorm:"primary_key"`
 	CreatedAt time.Time
@@ -9,6 +44,19 @@ type Player struct {
 
 	Name   string
 	Avatar string
-	TeamID int
+	// Free agents have no team
+	TeamID *int
 	Team   Team `gorm:"foreignkey:TeamID" sql:"type:int REFERENCES teams(id)"`
+
+	Position     string
+	JerseyNumber *int
+	// Height in inches
+	Height int
+	// Weight in pounds
+	Weight    int
+	BirthDate *time.Time `sql:"type:date"`
+	College   string
+	// Accrued seasons in the league
+	Experience int
+	Status     string
 }
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+type Season struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Year  int    `gorm:"unique_index"`
+	Games []Game `gorm:"foreignkey:SeasonID"`
+}
@@ -0,0 +1,61 @@
+package models
+
+import "time"
+
+// Statistics of a player in a single game, column names are used for aggregation and leaders
+type Stats struct {
+	PassAttempts           int
+	PassCompletions        int
+	PassingYards           int
+	PassingTouchdowns      int
+	PassingInterceptions   int
+	RushAttempts           int
+	RushingYards           int
+	RushingTouchdowns      int
+	Targets                int
+	Receptions             int
+	ReceivingYards         int
+	ReceivingTouchdowns    int
+	Tackles                int
+	Sacks                  float64
+	DefensiveInterceptions int
+	ForcedFumbles          int
+}
+
+var StatColumns = []string{
+	"pass_attempts", "pass_completions", "passing_yards", "passing_touchdowns", "passing_interceptions",
+	"rush_attempts", "rushing_yards", "rushing_touchdowns",
+	"targets", "receptions", "receiving_yards", "receiving_touchdowns",
+	"tackles", "sacks", "defensive_interceptions", "forced_fumbles",
+}
+
+// Stat line of a player in a game, team is the one player played for in that game
+type StatLine struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	PlayerID int
+	Player   Player `gorm:"foreignkey:PlayerID" sql:"type:int REFERENCES players(id)"`
+	GameID   int
+	Game     Game `gorm:"foreignkey:GameID" sql:"type:int REFERENCES games(id)"`
+	TeamID   int
+	Stats
+}
+
+// Stats summed over a season or a week
+type StatTotals struct {
+	Season int
+	Week   int
+	Games  int
+	Stats
+}
+
+// Player ranked by a single stat
+type Leader struct {
+	Rank     int
+	PlayerID int
+	Player   string
+	Games    int
+	Value    float64
+}
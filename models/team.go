@@ -9,7 +9,9 @@ type Team struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
-	Name    string
-	Logo    string
-	Players []Player `gorm:"foreignkey:TeamID"`
+	Name       string
+	Logo       string
+	DivisionID *int
+	Division   Division `gorm:"foreignkey:DivisionID" sql:"type:int REFERENCES divisions(id)"`
+	Players    []Player `gorm:"foreignkey:TeamID"`
 }
@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+// Transaction types
+const (
+	TransactionSign           = "sign"
+	TransactionRelease        = "release"
+	TransactionTrade          = "trade"
+	TransactionWaive          = "waive"
+	TransactionInjuredReserve = "injured_reserve"
+	TransactionActivate       = "activate"
+	TransactionSuspend        = "suspend"
+	// Recorded by the draft only, it can't be requested directly
+	TransactionDraft = "draft"
+)
+
+var TransactionTypes = []string{
+	TransactionSign, TransactionRelease, TransactionTrade, TransactionWaive, TransactionInjuredReserve,
+	TransactionActivate, TransactionSuspend,
+}
+
+// Transaction is an immutable record of a roster move, it is only ever inserted
+type Transaction struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+
+	Type       string
+	PlayerID   int
+	Player     Player `gorm:"foreignkey:PlayerID" sql:"type:int REFERENCES players(id)"`
+	FromTeamID *int
+	ToTeamID   *int
+	// Roster status of the player after the transaction
+	Status string
+	Note   string
+	// Set for players moved by a multi-player trade
+	TradeID *int
+}
+
+// Trade groups transactions of all players and draft picks exchanged between teams
+type Trade struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+
+	Note          string
+	Transactions  []Transaction  `gorm:"foreignkey:TradeID"`
+	PickTransfers []PickTransfer `gorm:"foreignkey:TradeID"`
+}
@@ -0,0 +1,61 @@
+package models
+
+import "time"
+
+// Roles of users, admins may modify anything, team managers only their teams and viewers nothing
+const (
+	RoleAdmin       = "admin"
+	RoleTeamManager = "team_manager"
+	RoleViewer      = "viewer"
+)
+
+var Roles = []string{RoleAdmin, RoleTeamManager, RoleViewer}
+
+type User struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Email        string `gorm:"unique_index"`
+	PasswordHash string
+	Role         string
+	// Teams managed by team managers
+	Teams []Team `gorm:"many2many:user_teams"`
+}
+
+// IDs of managed teams
+func (u User) TeamIDs() []int {
+	ids := make([]int, len(u.Teams))
+
+	for i, t := range u.Teams {
+		ids[i] = int(t.ID)
+	}
+
+	return ids
+}
+
+// APIKey is a long-lived credential of service callers, only hash of the key is stored
+type APIKey struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+
+	UserID int
+	User   User
+	Name   string
+	// First characters of the key to recognize it in listings
+	Prefix     string
+	KeyHash    string `gorm:"unique_index"`
+	LastUsedAt *time.Time
+}
+
+// RefreshToken is exchanged for a new pair of tokens once, only hash of the token is stored
+type RefreshToken struct {
+	ID        uint `gorm:"primary_key"`
+	CreatedAt time.Time
+
+	UserID    int
+	User      User
+	TokenHash string `gorm:"unique_index"`
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+}
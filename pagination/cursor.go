@@ -0,0 +1,139 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Cursor points at the last row of the previous page in keyset pagination
+type Cursor struct {
+	// Sort key the cursor was built for, e.g. "-created_at"
+	Sort string      `json:"s"`
+	Key  interface{} `json:"k,omitempty"`
+	ID   uint        `json:"id"`
+}
+
+const cursorParam = "cursor"
+
+var (
+	cursorSecret     []byte
+	cursorSecretOnce sync.Once
+)
+
+// Build pagination from query parameters, keyset mode is used when cursor parameter is present
+func Parse(params url.Values) (Pagination, error) {
+	if _, ok := params[cursorParam]; !ok {
+		return New(params), nil
+	}
+
+	envLimit, _ := strconv.Atoi(os.Getenv("PAGINATION_LIMIT"))
+
+	limit := parseLimit(envLimit)
+
+	if limits, ok := params["limit"]; ok {
+		l, _ := strconv.Atoi(limits[0])
+
+		limit = parseLimit(l)
+	}
+
+	pagination := Pagination{Limit: limit, Keyset: true}
+
+	if token := params.Get(cursorParam); token != "" {
+		cursor, err := DecodeCursor(token)
+
+		if err != nil {
+			return Pagination{}, err
+		}
+
+		pagination.Cursor = cursor
+	}
+
+	return pagination, nil
+}
+
+// Build listing metadata for keyset pagination
+func (p Pagination) CursorMeta(next *Cursor) Meta {
+	meta := Meta{PerPage: p.Limit, Keyset: true}
+
+	if next != nil {
+		meta.NextCursor = EncodeCursor(*next)
+	}
+
+	return meta
+}
+
+// Encode cursor into opaque signed token
+func EncodeCursor(cursor Cursor) string {
+	payload, _ := json.Marshal(cursor)
+
+	return encode(payload) + "." + encode(sign(payload))
+}
+
+// Decode token created by EncodeCursor, tokens with invalid signature are rejected
+func DecodeCursor(token string) (*Cursor, error) {
+	parts := strings.Split(token, ".")
+
+	if len(parts) != 2 {
+		return nil, apperrors.ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+
+	if err != nil {
+		return nil, apperrors.ErrInvalidCursor
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+
+	if err != nil || !hmac.Equal(signature, sign(payload)) {
+		return nil, apperrors.ErrInvalidCursor
+	}
+
+	var cursor Cursor
+
+	decoder := json.NewDecoder(strings.NewReader(string(payload)))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&cursor); err != nil {
+		return nil, apperrors.ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func sign(payload []byte) []byte {
+	cursorSecretOnce.Do(func() {
+		cursorSecret = loadCursorSecret()
+	})
+
+	mac := hmac.New(sha256.New, cursorSecret)
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
+
+// Secret is taken from PAGINATION_CURSOR_SECRET, random one is used when it is not set
+// so cursors are valid only until restart
+func loadCursorSecret() []byte {
+	if secret := os.Getenv("PAGINATION_CURSOR_SECRET"); secret != "" {
+		return []byte(secret)
+	}
+
+	secret := make([]byte, 32)
+	rand.Read(secret)
+
+	return secret
+}
@@ -1,13 +1,49 @@
 package pagination
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
 )
 
+// Largest page size clients may request
+const MaxLimit = 100
+
 type Pagination struct {
 	Page, Limit, Offset int
+
+	// Keyset pagination, rows after the cursor are returned (first page when cursor is nil)
+	Keyset bool
+	Cursor *Cursor
+}
+
+// Meta describes page of the listing returned to the client
+type Meta struct {
+	Page       int `json:"page"`
+	PerPage    int `json:"per_page"`
+	Total      int `json:"total"`
+	TotalPages int `json:"total_pages"`
+
+	Keyset     bool   `json:"-"`
+	NextCursor string `json:"-"`
+}
+
+type offsetMeta Meta
+
+type cursorMeta struct {
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+func (m Meta) MarshalJSON() ([]byte, error) {
+	if m.Keyset {
+		return json.Marshal(cursorMeta{Limit: m.PerPage, NextCursor: m.NextCursor})
+	}
+
+	return json.Marshal(offsetMeta(m))
 }
 
 func New(params url.Values) Pagination {
@@ -26,6 +62,10 @@ func (p *Pagination) create(page, limit int) {
 		l = 1
 	}
 
+	if page <= 0 {
+		page = 1
+	}
+
 	p.Limit = l
 	p.Page = page
 	p.Offset = (page - 1) * l
@@ -53,6 +93,7 @@ func parseParams(params url.Values) (page, limit int) {
 	return
 }
 
+// Limit is kept between 1 and MaxLimit
 func parseLimit(limit int) int {
 	l := limit
 
@@ -60,5 +101,90 @@ func parseLimit(limit int) int {
 		l = 1
 	}
 
+	if l > MaxLimit {
+		l = MaxLimit
+	}
+
 	return l
 }
+
+// Build listing metadata from total count of rows
+func (p Pagination) Meta(total int) Meta {
+	totalPages := total / p.Limit
+
+	if total%p.Limit != 0 {
+		totalPages++
+	}
+
+	return Meta{
+		Page:       p.Page,
+		PerPage:    p.Limit,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+}
+
+// Link header value (RFC 5988) with first, prev, next and last pages of given request URL
+func (m Meta) Link(u url.URL) string {
+	if m.Keyset {
+		return m.cursorLink(u)
+	}
+
+	lastPage := m.TotalPages
+
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	links := []string{m.link(u, 1, "first")}
+
+	if m.Page > 1 {
+		prevPage := m.Page - 1
+
+		if prevPage > lastPage {
+			prevPage = lastPage
+		}
+
+		links = append(links, m.link(u, prevPage, "prev"))
+	}
+
+	if m.Page < lastPage {
+		links = append(links, m.link(u, m.Page+1, "next"))
+	}
+
+	links = append(links, m.link(u, lastPage, "last"))
+
+	return strings.Join(links, ", ")
+}
+
+func (m Meta) link(u url.URL, page int, rel string) string {
+	params := u.Query()
+
+	params.Set("page", strconv.Itoa(page))
+	params.Set("per_page", strconv.Itoa(m.PerPage))
+
+	u.RawQuery = params.Encode()
+
+	return fmt.Sprintf("<%s>; rel=\"%s\"", u.String(), rel)
+}
+
+func (m Meta) cursorLink(u url.URL) string {
+	links := []string{m.linkCursor(u, "", "first")}
+
+	if m.NextCursor != "" {
+		links = append(links, m.linkCursor(u, m.NextCursor, "next"))
+	}
+
+	return strings.Join(links, ", ")
+}
+
+func (m Meta) linkCursor(u url.URL, cursor, rel string) string {
+	params := u.Query()
+
+	params.Set(cursorParam, cursor)
+	params.Set("limit", strconv.Itoa(m.PerPage))
+
+	u.RawQuery = params.Encode()
+
+	return fmt.Sprintf("<%s>; rel=\"%s\"", u.String(), rel)
+}
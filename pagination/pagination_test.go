@@ -0,0 +1,79 @@
+package pagination
+
+import (
+	"encoding/json"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/stretchr/testify/assert"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestPagination_Meta(t *testing.T) {
+	paging := New(url.Values{"page": []string{"2"}, "per_page": []string{"10"}})
+
+	assert.Equal(t, Meta{Page: 2, PerPage: 10, Total: 25, TotalPages: 3}, paging.Meta(25))
+	assert.Equal(t, Meta{Page: 2, PerPage: 10, Total: 0, TotalPages: 0}, paging.Meta(0))
+}
+
+func TestNew_MaxLimit(t *testing.T) {
+	assert.Equal(t, MaxLimit, New(url.Values{"per_page": []string{"100000"}}).Limit)
+	assert.Equal(t, 1, New(url.Values{"per_page": []string{"-5"}}).Limit)
+
+	paging, err := Parse(url.Values{"cursor": []string{""}, "limit": []string{"100000"}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, MaxLimit, paging.Limit)
+}
+
+func TestMeta_Link(t *testing.T) {
+	u, _ := url.Parse("/players?team_id=3&page=2&per_page=10")
+	meta := Meta{Page: 2, PerPage: 10, Total: 25, TotalPages: 3}
+
+	assert.Equal(
+		t,
+		`</players?page=1&per_page=10&team_id=3>; rel="first", `+
+			`</players?page=1&per_page=10&team_id=3>; rel="prev", `+
+			`</players?page=3&per_page=10&team_id=3>; rel="next", `+
+			`</players?page=3&per_page=10&team_id=3>; rel="last"`,
+		meta.Link(*u),
+	)
+}
+
+func TestParse_Cursor(t *testing.T) {
+	token := EncodeCursor(Cursor{Sort: "-created_at", Key: "2018-12-14T11:44:32.779195Z", ID: 7})
+
+	paging, err := Parse(url.Values{"cursor": []string{token}, "limit": []string{"5"}})
+
+	assert.Nil(t, err)
+	assert.True(t, paging.Keyset)
+	assert.Equal(t, 5, paging.Limit)
+	assert.Equal(t, "-created_at", paging.Cursor.Sort)
+	assert.Equal(t, uint(7), paging.Cursor.ID)
+
+	paging, err = Parse(url.Values{"cursor": []string{""}})
+
+	assert.Nil(t, err)
+	assert.True(t, paging.Keyset)
+	assert.Nil(t, paging.Cursor)
+}
+
+func TestParse_TamperedCursor(t *testing.T) {
+	token := EncodeCursor(Cursor{Sort: "id", ID: 7})
+	forged := EncodeCursor(Cursor{Sort: "id", ID: 100})
+
+	_, err := Parse(url.Values{"cursor": []string{strings.Split(forged, ".")[0] + "." + strings.Split(token, ".")[1]}})
+
+	assert.Equal(t, apperrors.ErrInvalidCursor, err)
+}
+
+func TestMeta_MarshalJSON(t *testing.T) {
+	paging := Pagination{Limit: 5, Keyset: true}
+
+	data, err := json.Marshal(paging.CursorMeta(&Cursor{Sort: "id", ID: 7}))
+
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), `"limit":5`)
+	assert.Contains(t, string(data), `"next_cursor":`)
+	assert.NotContains(t, string(data), `"total"`)
+}
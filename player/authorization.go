@@ -0,0 +1,87 @@
+package player
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/logansua/nfl_app/auth"
+	"github.com/logansua/nfl_app/bucket"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/utils"
+)
+
+// Team managers may modify players of their teams and move them only between their teams
+func authorizeCreatePlayer() endpoint.Middleware {
+	return auth.ManageTeams(func(_ context.Context, request interface{}) ([]*int, error) {
+		req := request.(createPlayerRequest)
+
+		return []*int{req.Player.TeamID}, nil
+	})
+}
+func authorizeUpdatePlayer(s Service) endpoint.Middleware {
+	return auth.ManageTeams(func(ctx context.Context, request interface{}) ([]*int, error) {
+		req := request.(updatePlayerRequest)
+
+		current, err := playerTeam(ctx, s, req.id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []*int{current, req.Player.TeamID}, nil
+	})
+}
+func authorizePatchPlayer(s Service) endpoint.Middleware {
+	return auth.ManageTeams(func(ctx context.Context, request interface{}) ([]*int, error) {
+		req := request.(patchPlayerRequest)
+
+		current, err := playerTeam(ctx, s, req.id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Malformed patches are rejected by the service
+		var target *int
+
+		ok, err := utils.PatchedField(req.Patch, "team_id", &target)
+
+		if err != nil {
+			return nil, apperrors.ErrInvalidArgument
+		}
+
+		if !ok {
+			return []*int{current}, nil
+		}
+
+		return []*int{current, target}, nil
+	})
+}
+func authorizeDeletePlayer(s Service) endpoint.Middleware {
+	return auth.ManageTeams(func(ctx context.Context, request interface{}) ([]*int, error) {
+		req := request.(playerIdRequest)
+
+		current, err := playerTeam(ctx, s, req.id)
+
+		return []*int{current}, err
+	})
+}
+func authorizeUploadPlayerAvatar(s Service) endpoint.Middleware {
+	return auth.ManageTeams(func(ctx context.Context, request interface{}) ([]*int, error) {
+		req := request.(bucket.UploadFileToBucketRequest)
+
+		current, err := playerTeam(ctx, s, req.ID)
+
+		return []*int{current}, err
+	})
+}
+
+func playerTeam(ctx context.Context, s Service, id int) (*int, error) {
+	var p dto.PlayerDTO
+
+	if err := s.GetPlayer(ctx, id, &p); err != nil {
+		return nil, err
+	}
+
+	return p.TeamID, nil
+}
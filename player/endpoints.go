@@ -6,7 +6,9 @@ import (
 	"github.com/logansua/nfl_app/bucket"
 	"github.com/logansua/nfl_app/models/dto"
 	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
 	"github.com/logansua/nfl_app/utils"
+	"github.com/logansua/nfl_app/validation"
 	"mime/multipart"
 )
 
@@ -14,17 +16,23 @@ type Endpoints struct {
 	CreatePlayerEndpoint           endpoint.Endpoint
 	GetPlayersEndpoint             endpoint.Endpoint
 	GetPlayerEndpoint              endpoint.Endpoint
+	UpdatePlayerEndpoint           endpoint.Endpoint
+	PatchPlayerEndpoint            endpoint.Endpoint
 	DeletePlayerEndpoint           endpoint.Endpoint
 	MakeUploadPlayerAvatarEndpoint endpoint.Endpoint
 }
 
-func MakeServerEndpoints(s Service) Endpoints {
+func MakeServerEndpoints(s Service, authenticate endpoint.Middleware) Endpoints {
+	validate := validation.Middleware()
+
 	return Endpoints{
-		CreatePlayerEndpoint:           MakeCreatePlayerEndpoint(s),
-		GetPlayersEndpoint:             MakeGetPlayersEndpoint(s),
-		GetPlayerEndpoint:              MakeGetPlayerEndpoint(s),
-		DeletePlayerEndpoint:           MakeDeletePlayerEndpoint(s),
-		MakeUploadPlayerAvatarEndpoint: MakeUploadPlayerAvatarEndpoint(s),
+		CreatePlayerEndpoint:           authenticate(authorizeCreatePlayer()(validate(MakeCreatePlayerEndpoint(s)))),
+		GetPlayersEndpoint:             authenticate(MakeGetPlayersEndpoint(s)),
+		GetPlayerEndpoint:              authenticate(MakeGetPlayerEndpoint(s)),
+		UpdatePlayerEndpoint:           authenticate(authorizeUpdatePlayer(s)(validate(MakeUpdatePlayerEndpoint(s)))),
+		PatchPlayerEndpoint:            authenticate(authorizePatchPlayer(s)(MakePatchPlayerEndpoint(s))),
+		DeletePlayerEndpoint:           authenticate(authorizeDeletePlayer(s)(MakeDeletePlayerEndpoint(s))),
+		MakeUploadPlayerAvatarEndpoint: authenticate(authorizeUploadPlayerAvatar(s)(MakeUploadPlayerAvatarEndpoint(s))),
 	}
 }
 
@@ -40,20 +48,20 @@ func (e Endpoints) CreatePlayer(ctx context.Context, p dto.PlayerDTO) error {
 
 	return resp.Err
 }
-func (e Endpoints) GetPlayers(ctx context.Context, paging pagination.Pagination) error {
-	request := getPlayersRequest{Paging: paging}
+func (e Endpoints) GetPlayers(ctx context.Context, paging pagination.Pagination, q query.Query) error {
+	request := getPlayersRequest{Paging: paging, Query: q}
 	response, err := e.GetPlayersEndpoint(ctx, request)
 
 	if err != nil {
 		return err
 	}
 
-	resp := response.(utils.DataResponse)
+	resp := response.(utils.PaginatedResponse)
 
 	return resp.Err
 }
-func (e Endpoints) GetPlayer(ctx context.Context, id int) error {
-	request := playerIdRequest{id: id}
+func (e Endpoints) GetPlayer(ctx context.Context, id int, include query.Include) error {
+	request := getPlayerRequest{id: id, Include: include}
 	response, err := e.GetPlayerEndpoint(ctx, request)
 
 	if err != nil {
@@ -64,6 +72,30 @@ func (e Endpoints) GetPlayer(ctx context.Context, id int) error {
 
 	return resp.Err
 }
+func (e Endpoints) UpdatePlayer(ctx context.Context, id int, p dto.PlayerDTO) error {
+	request := updatePlayerRequest{id: id, Player: p}
+	response, err := e.UpdatePlayerEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) PatchPlayer(ctx context.Context, id int, patch []byte) error {
+	request := patchPlayerRequest{id: id, Patch: patch}
+	response, err := e.PatchPlayerEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
 func (e Endpoints) DeletePlayer(ctx context.Context, id int) error {
 	request := playerIdRequest{id: id}
 	response, err := e.DeletePlayerEndpoint(ctx, request)
@@ -109,23 +141,58 @@ func MakeGetPlayersEndpoint(service Service) endpoint.Endpoint {
 		req := request.(getPlayersRequest)
 
 		var players []dto.PlayerDTO
+		var meta pagination.Meta
 
-		err = service.GetPlayers(ctx, req.Paging, &players)
+		err = service.GetPlayers(ctx, req.Paging, req.Query, &players, &meta)
 
 		if err != nil {
 			return nil, err
 		}
 
-		return utils.DataResponse{Data: players}, nil
+		return utils.PaginatedResponse{Data: players, Meta: meta}, nil
 	}
 }
 func MakeGetPlayerEndpoint(service Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
-		req := request.(playerIdRequest)
+		req := request.(getPlayerRequest)
+
+		var player dto.PlayerDTO
+
+		if req.Include.Has("team") {
+			err = service.GetPlayerWithTeam(ctx, req.id, &player)
+		} else {
+			err = service.GetPlayer(ctx, req.id, &player)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: player}, nil
+	}
+}
+func MakeUpdatePlayerEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(updatePlayerRequest)
+
+		p := req.Player
+
+		err = service.UpdatePlayer(ctx, req.id, &p)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: p}, nil
+	}
+}
+func MakePatchPlayerEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(patchPlayerRequest)
 
 		var player dto.PlayerDTO
 
-		err = service.GetPlayer(ctx, req.id, &player)
+		err = service.PatchPlayer(ctx, req.id, req.Patch, &player)
 
 		if err != nil {
 			return nil, err
@@ -166,8 +233,24 @@ type createPlayerRequest struct {
 	Player dto.PlayerDTO
 }
 
+type updatePlayerRequest struct {
+	id     int
+	Player dto.PlayerDTO
+}
+
+type patchPlayerRequest struct {
+	id    int
+	Patch []byte
+}
+
 type getPlayersRequest struct {
 	Paging pagination.Pagination
+	Query  query.Query
+}
+
+type getPlayerRequest struct {
+	id      int
+	Include query.Include
 }
 
 type playerIdRequest struct {
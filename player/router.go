@@ -2,36 +2,28 @@ package player
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
+	"github.com/go-kit/kit/endpoint"
 	"github.com/go-kit/kit/log"
 	httptransport "github.com/go-kit/kit/transport/http"
 	"github.com/gorilla/mux"
 	"github.com/logansua/nfl_app/bucket"
-	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/db"
+	"github.com/logansua/nfl_app/models"
 	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
 	"github.com/logansua/nfl_app/router"
+	"github.com/logansua/nfl_app/validation"
+	"io/ioutil"
 	"net/http"
 	"strconv"
 )
 
 func GetServiceOptions(logger log.Logger) []httptransport.ServerOption {
-	return []httptransport.ServerOption{
-		httptransport.ServerErrorLogger(logger),
-		httptransport.ServerErrorEncoder(encodeError),
-		httptransport.ServerAfter(func(ctx context.Context, writer http.ResponseWriter) context.Context {
-			writer.Header().Set("Content-type", "application/json")
-
-			return ctx
-		}),
-		httptransport.ServerFinalizer(func(ctx context.Context, code int, r *http.Request) {
-			logger.Log("METHOD", r.Method, "PATH", r.URL.Path, "CODE", code)
-		}),
-	}
+	return router.ServerOptions(logger)
 }
 
-func CreateRoutes(s Service, logger log.Logger) []router.Route {
-	endpoints := MakeServerEndpoints(s)
+func CreateRoutes(s Service, logger log.Logger, authenticate endpoint.Middleware) []router.Route {
+	endpoints := MakeServerEndpoints(s, authenticate)
 
 	options := GetServiceOptions(logger)
 
@@ -44,7 +36,7 @@ func CreateRoutes(s Service, logger log.Logger) []router.Route {
 			Handler: httptransport.NewServer(
 				endpoints.CreatePlayerEndpoint,
 				decodeCreatePlayerRequest,
-				encodeResponse,
+				router.EncodeResponse,
 				options...,
 			),
 		},
@@ -56,7 +48,7 @@ func CreateRoutes(s Service, logger log.Logger) []router.Route {
 			Handler: httptransport.NewServer(
 				endpoints.GetPlayersEndpoint,
 				decodeGetPlayersRequest,
-				encodeResponse,
+				router.EncodePaginatedResponse,
 				options...,
 			),
 		},
@@ -68,7 +60,31 @@ func CreateRoutes(s Service, logger log.Logger) []router.Route {
 			Handler: httptransport.NewServer(
 				endpoints.GetPlayerEndpoint,
 				decodeGetPlayerRequest,
-				encodeResponse,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Update player",
+			Method:      http.MethodPut,
+			Path:        "/players/{id}",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.UpdatePlayerEndpoint,
+				decodeUpdatePlayerRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Patch player",
+			Method:      http.MethodPatch,
+			Path:        "/players/{id}",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.PatchPlayerEndpoint,
+				decodePatchPlayerRequest,
+				router.EncodeResponse,
 				options...,
 			),
 		},
@@ -80,7 +96,7 @@ func CreateRoutes(s Service, logger log.Logger) []router.Route {
 			Handler: httptransport.NewServer(
 				endpoints.DeletePlayerEndpoint,
 				decodeDeletePlayerRequest,
-				encodeDeletePlayerResponse,
+				router.EncodeNoContentResponse,
 				options...,
 			),
 		},
@@ -92,7 +108,7 @@ func CreateRoutes(s Service, logger log.Logger) []router.Route {
 			Handler: httptransport.NewServer(
 				endpoints.MakeUploadPlayerAvatarEndpoint,
 				decodeUploadPlayerAvatarRequest,
-				encodeResponse,
+				router.EncodeResponse,
 				options...,
 			),
 		},
@@ -102,10 +118,14 @@ func CreateRoutes(s Service, logger log.Logger) []router.Route {
 func decodeCreatePlayerRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
 	var req createPlayerRequest
 
-	if e := json.NewDecoder(r.Body).Decode(&req.Player); e != nil {
+	if e := validation.DecodeJSON(r.Body, &req.Player); e != nil {
 		return nil, e
 	}
 
+	if req.Player.Status == "" {
+		req.Player.Status = models.PlayerActive
+	}
+
 	return req, nil
 }
 func decodeGetPlayersRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
@@ -113,14 +133,35 @@ func decodeGetPlayersRequest(_ context.Context, r *http.Request) (request interf
 
 	params := r.URL.Query()
 
-	paging := pagination.New(params)
+	paging, err := pagination.Parse(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := query.New(params, db.PlayerQueryFields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	include, err := query.NewInclude(params, "team")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if include.Has("team") {
+		q.Preload = append(q.Preload, "Team")
+	}
 
 	req.Paging = paging
+	req.Query = q
 
 	return req, nil
 }
 func decodeGetPlayerRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
-	var req playerIdRequest
+	var req getPlayerRequest
 
 	params := mux.Vars(r)
 
@@ -130,12 +171,19 @@ func decodeGetPlayerRequest(_ context.Context, r *http.Request) (request interfa
 		return nil, err
 	}
 
+	include, err := query.NewInclude(r.URL.Query(), "team")
+
+	if err != nil {
+		return nil, err
+	}
+
 	req.id = id
+	req.Include = include
 
 	return req, nil
 }
-func decodeDeletePlayerRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
-	var req playerIdRequest
+func decodeUpdatePlayerRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req updatePlayerRequest
 
 	params := mux.Vars(r)
 
@@ -145,96 +193,85 @@ func decodeDeletePlayerRequest(_ context.Context, r *http.Request) (request inte
 		return nil, err
 	}
 
+	if e := validation.DecodeJSON(r.Body, &req.Player); e != nil {
+		return nil, e
+	}
+
+	if req.Player.Status == "" {
+		req.Player.Status = models.PlayerActive
+	}
+
 	req.id = id
 
 	return req, nil
 }
-func decodeUploadPlayerAvatarRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
-	const (
-		maxUploadSize = 2 * 1024 * 1024 // 2 mb
-	)
+func decodePatchPlayerRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req patchPlayerRequest
 
-	//r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	params := mux.Vars(r)
 
-	if err := r.ParseMultipartForm(maxUploadSize); err != nil {
-		return nil, errors.New("file is too big")
-	}
+	id, err := strconv.Atoi(params["id"])
 
-	file, fileHeader, err := r.FormFile("image")
-	if err == http.ErrMissingFile {
-		return
-	}
 	if err != nil {
 		return nil, err
 	}
 
-	var req bucket.UploadFileToBucketRequest
-
-	params := mux.Vars(r)
-
-	id, err := strconv.Atoi(params["id"])
+	patch, err := ioutil.ReadAll(r.Body)
 
 	if err != nil {
 		return nil, err
 	}
 
-	req.ID = id
-	req.File = file
-	req.FileHeader = *fileHeader
+	req.id = id
+	req.Patch = patch
 
 	return req, nil
 }
+func decodeDeletePlayerRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req playerIdRequest
 
-type errorer interface {
-	error() error
-}
+	params := mux.Vars(r)
 
-func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
-	if e, ok := response.(errorer); ok && e.error() != nil {
-		// Not a Go kit transport error, but a business-logic error.
-		// Provide those as HTTP errors.
-		encodeError(ctx, e.error(), w)
+	id, err := strconv.Atoi(params["id"])
 
-		return nil
+	if err != nil {
+		return nil, err
 	}
 
-	return json.NewEncoder(w).Encode(response)
+	req.id = id
+
+	return req, nil
 }
+func decodeUploadPlayerAvatarRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	const (
+		maxUploadSize = 2 * 1024 * 1024 // 2 mb, whole body including form fields
+	)
 
-func encodeDeletePlayerResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
-	if e, ok := response.(errorer); ok && e.error() != nil {
-		// Not a Go kit transport error, but a business-logic error.
-		// Provide those as HTTP errors.
-		encodeError(ctx, e.error(), w)
+	if err := router.ParseMultipartForm(r, maxUploadSize); err != nil {
+		return nil, err
+	}
 
-		return nil
+	file, fileHeader, err := r.FormFile("image")
+	if err == http.ErrMissingFile {
+		return nil, validation.Errors{{Field: "image", Code: validation.CodeRequired}}
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	var req bucket.UploadFileToBucketRequest
 
-	return json.NewEncoder(w).Encode(response)
-}
+	params := mux.Vars(r)
 
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
-	if err == nil {
-		panic("encodeError with nil error")
-	}
+	id, err := strconv.Atoi(params["id"])
 
-	w.Header().Set("Content-type", "application/json")
-	w.WriteHeader(codeFrom(err))
+	if err != nil {
+		return nil, err
+	}
 
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"error": err.Error(),
-	})
-}
+	req.ID = id
+	req.File = file
+	req.FileHeader = *fileHeader
 
-func codeFrom(err error) int {
-	switch err {
-	case apperrors.ErrNotFound:
-		return http.StatusNotFound
-	case apperrors.ErrAlreadyExists, apperrors.ErrInconsistentIDs:
-		return http.StatusBadRequest
-	default:
-		return http.StatusInternalServerError
-	}
+	return req, nil
 }
@@ -1,7 +1,9 @@
 package player
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"github.com/logansua/nfl_app/bucket"
 	"github.com/logansua/nfl_app/db"
@@ -9,18 +11,31 @@ import (
 	"github.com/logansua/nfl_app/models"
 	"github.com/logansua/nfl_app/models/dto"
 	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
 	"github.com/logansua/nfl_app/team"
+	"github.com/logansua/nfl_app/utils"
+	"github.com/logansua/nfl_app/validation"
 	"mime/multipart"
 )
 
+// Team and status of the player are changed only by roster moves (POST /transactions), which record
+// the move and keep contracts in sync
+const CodeTransactionRequired = "transaction_required"
+
 // Service is a simple CRUD interface for players.
 type Service interface {
 	// Create player
 	CreatePlayer(ctx context.Context, player *dto.PlayerDTO) error
 	// Get list of players
-	GetPlayers(ctx context.Context, paging pagination.Pagination, players *[]dto.PlayerDTO) error
+	GetPlayers(ctx context.Context, paging pagination.Pagination, q query.Query, players *[]dto.PlayerDTO, meta *pagination.Meta) error
 	// Get single player by ID
 	GetPlayer(ctx context.Context, id int, player *dto.PlayerDTO) error
+	// Get single player by ID with preloaded team
+	GetPlayerWithTeam(ctx context.Context, id int, player *dto.PlayerDTO) error
+	// Update player by ID
+	UpdatePlayer(ctx context.Context, id int, player *dto.PlayerDTO) error
+	// Partially update player by ID with JSON merge patch
+	PatchPlayer(ctx context.Context, id int, patch []byte, player *dto.PlayerDTO) error
 	// Delete player by ID
 	DeletePlayer(ctx context.Context, id int) error
 	// Upload player avatar by ID
@@ -44,11 +59,16 @@ func New(dbService *db.DB, bucketService bucket.Service, teamService team.Servic
 func (s *service) CreatePlayer(ctx context.Context, player *dto.PlayerDTO) error {
 	p := models.NewPlayerModel(player)
 
-	var teamDTO dto.TeamDTO
-	err := s.TeamService.GetTeam(ctx, p.TeamID, &teamDTO)
+	err := s.checkTeam(ctx, p.TeamID)
+
+	if err != nil {
+		return err
+	}
+
+	err = s.checkJerseyNumber(p)
 
 	if err != nil {
-		return apperrors.ErrNotFound
+		return err
 	}
 
 	err = s.DB.Repository.Create(&p)
@@ -64,12 +84,75 @@ func (s *service) CreatePlayer(ctx context.Context, player *dto.PlayerDTO) error
 	return err
 }
 
-func (s *service) GetPlayers(ctx context.Context, paging pagination.Pagination, players *[]dto.PlayerDTO) error {
+// Referential check of player team, free agents have no team
+func (s *service) checkTeam(ctx context.Context, teamID *int) error {
+	if teamID == nil {
+		return nil
+	}
+
+	var teamDTO dto.TeamDTO
+
+	err := s.TeamService.GetTeam(ctx, *teamID, &teamDTO)
+
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return validation.Errors{{Field: "team_id", Code: validation.CodeNotFound}}
+	}
+
+	return err
+}
+
+// Jersey numbers are unique within the team's active roster
+func (s *service) checkJerseyNumber(p models.Player) error {
+	if p.TeamID == nil || p.JerseyNumber == nil || p.Status != models.PlayerActive {
+		return nil
+	}
+
+	var other models.Player
+
+	err := s.DB.PlayerRepository.FindActiveByJerseyNumber(*p.TeamID, *p.JerseyNumber, &other)
+
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if other.ID != p.ID {
+		return validation.Errors{{Field: "jersey_number", Code: validation.CodeTaken}}
+	}
+
+	return nil
+}
+
+// Changes of team or status are rejected, they must go through the transaction service
+func checkRosterMove(p models.Player, player dto.PlayerDTO) error {
+	return validation.New().
+		Check(models.SameID(p.TeamID, player.TeamID), "team_id", CodeTransactionRequired).
+		Check(p.Status == player.Status, "status", CodeTransactionRequired).
+		Err()
+}
+
+// Replace editable fields of the player with the DTO, team and status are kept
+func assign(p *models.Player, player *dto.PlayerDTO) {
+	changes := models.NewPlayerModel(player)
+
+	changes.ID = p.ID
+	changes.TeamID = p.TeamID
+	changes.Status = p.Status
+	changes.CreatedAt = p.CreatedAt
+	changes.UpdatedAt = p.UpdatedAt
+
+	*p = changes
+}
+
+func (s *service) GetPlayers(ctx context.Context, paging pagination.Pagination, q query.Query, players *[]dto.PlayerDTO, meta *pagination.Meta) error {
 	var p []models.Player
 
 	err := s.DB.
 		PlayerRepository.
-		FindAllAndPaginate(paging, &p)
+		FindAllAndPaginate(paging, q, &p, meta)
 
 	*players = make([]dto.PlayerDTO, len(p))
 
@@ -90,6 +173,98 @@ func (s *service) GetPlayer(ctx context.Context, id int, player *dto.PlayerDTO)
 	return err
 }
 
+func (s *service) GetPlayerWithTeam(ctx context.Context, id int, player *dto.PlayerDTO) error {
+	var p models.Player
+
+	err := s.DB.PlayerRepository.FindWithTeam(id, &p)
+
+	*player = models.NewPlayerDTO(p)
+
+	return err
+}
+
+func (s *service) UpdatePlayer(ctx context.Context, id int, player *dto.PlayerDTO) error {
+	var p models.Player
+
+	err := s.DB.Repository.FindById(&p, id)
+
+	if err != nil {
+		return err
+	}
+
+	err = checkRosterMove(p, *player)
+
+	if err != nil {
+		return err
+	}
+
+	assign(&p, player)
+
+	err = s.checkJerseyNumber(p)
+
+	if err != nil {
+		return err
+	}
+
+	err = s.DB.Repository.Save(&p)
+
+	*player = models.NewPlayerDTO(p)
+
+	return err
+}
+
+func (s *service) PatchPlayer(ctx context.Context, id int, patch []byte, player *dto.PlayerDTO) error {
+	var p models.Player
+
+	err := s.DB.Repository.FindById(&p, id)
+
+	if err != nil {
+		return err
+	}
+
+	original, err := json.Marshal(models.NewPlayerDTO(p))
+
+	if err != nil {
+		return err
+	}
+
+	patched, err := utils.MergePatch(original, patch)
+
+	if err != nil {
+		return err
+	}
+
+	var changes dto.PlayerDTO
+
+	if err := validation.DecodeJSON(bytes.NewReader(patched), &changes); err != nil {
+		return err
+	}
+
+	if err := ValidatePlayer(changes); err != nil {
+		return err
+	}
+
+	err = checkRosterMove(p, changes)
+
+	if err != nil {
+		return err
+	}
+
+	assign(&p, &changes)
+
+	err = s.checkJerseyNumber(p)
+
+	if err != nil {
+		return err
+	}
+
+	err = s.DB.Repository.Save(&p)
+
+	*player = models.NewPlayerDTO(p)
+
+	return err
+}
+
 func (s *service) DeletePlayer(ctx context.Context, id int) error {
 	var p models.Player
 
@@ -108,16 +283,16 @@ func (s *service) UploadPlayerAvatar(ctx context.Context, id int, file multipart
 	var p models.Player
 
 	if err := s.DB.Repository.FindById(&p, id); err != nil {
-		return errors.New("player not found")
+		return err
 	}
 
-	name, err := s.BucketService.UploadPlayerAvatar(ctx, p.ID, file, fileHeader)
+	url, err := s.BucketService.UploadPlayerAvatar(ctx, p.ID, file, fileHeader)
 
 	if err != nil {
 		return err
 	}
 
-	p.Avatar = name
+	p.Avatar = url
 
 	err = s.DB.Repository.Save(&p)
 
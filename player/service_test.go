@@ -7,6 +7,9 @@ import (
 	"github.com/logansua/nfl_app/models"
 	"github.com/logansua/nfl_app/models/dto"
 	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
+	"github.com/logansua/nfl_app/team"
+	"github.com/logansua/nfl_app/validation"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"net/url"
@@ -14,6 +17,10 @@ import (
 	"time"
 )
 
+func intPtr(v int) *int {
+	return &v
+}
+
 func TestService_GetPlayer(t *testing.T) {
 	team := models.Team{
 		ID:        1,
@@ -27,7 +34,7 @@ func TestService_GetPlayer(t *testing.T) {
 		Name:      "TEST_PLAYER",
 		Avatar:    "TEST_AVATAR",
 		Team:      team,
-		TeamID:    1,
+		TeamID:    intPtr(1),
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -67,7 +74,7 @@ func TestService_GetPlayers(t *testing.T) {
 			Name:      "TEST_PLAYER",
 			Avatar:    "TEST_AVATAR",
 			Team:      team,
-			TeamID:    1,
+			TeamID:    intPtr(1),
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
@@ -82,12 +89,16 @@ func TestService_GetPlayers(t *testing.T) {
 	playerRepository.On(
 		"FindAllAndPaginate",
 		mock.AnythingOfType("pagination.Pagination"),
+		mock.AnythingOfType("query.Query"),
 		mock.AnythingOfType("*[]models.Player"),
+		mock.AnythingOfType("*pagination.Meta"),
 	).
 		Run(func(args mock.Arguments) {
-			arg := args.Get(1).(*[]models.Player)
+			arg := args.Get(2).(*[]models.Player)
+			meta := args.Get(3).(*pagination.Meta)
 
 			*arg = players
+			*meta = args.Get(0).(pagination.Pagination).Meta(len(players))
 		}).
 		Return(nil)
 
@@ -98,13 +109,16 @@ func TestService_GetPlayers(t *testing.T) {
 		"per_page": []string{"10"},
 	}
 	paging := pagination.New(values)
+	q, _ := query.New(values, db.PlayerQueryFields)
 	var actualPlayers []dto.PlayerDTO
+	var meta pagination.Meta
 
-	err := playerService.GetPlayers(context.Background(), paging, &actualPlayers)
+	err := playerService.GetPlayers(context.Background(), paging, q, &actualPlayers, &meta)
 
 	assert.Nil(t, err)
 	assert.NotEmpty(t, actualPlayers)
 	assert.Equal(t, playerDTOS, actualPlayers)
+	assert.Equal(t, pagination.Meta{Page: 1, PerPage: 10, Total: 1, TotalPages: 1}, meta)
 
 	playerRepository.AssertExpectations(t)
 }
@@ -122,7 +136,7 @@ func TestService_DeletePlayer(t *testing.T) {
 		Name:      "TEST_PLAYER",
 		Avatar:    "TEST_AVATAR",
 		Team:      team,
-		TeamID:    1,
+		TeamID:    intPtr(1),
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -146,3 +160,121 @@ func TestService_DeletePlayer(t *testing.T) {
 
 	repository.AssertExpectations(t)
 }
+
+func TestService_PatchPlayer(t *testing.T) {
+	player := models.Player{
+		ID:        1,
+		Name:      "TEST_PLAYER",
+		Avatar:    "TEST_AVATAR",
+		TeamID:    intPtr(1),
+		Status:    models.PlayerActive,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	repository := &mocks.Repository{}
+	repository.On("FindById", mock.AnythingOfType("*models.Player"), mock.AnythingOfType("int")).
+		Run(func(args mock.Arguments) {
+			arg := args.Get(0).(*models.Player)
+
+			*arg = player
+		}).
+		Return(nil)
+	repository.On("Save", mock.AnythingOfType("*models.Player")).
+		Return(nil)
+
+	dbService := &db.DB{Repository: repository}
+	playerService := New(dbService, nil, team.New(dbService, nil))
+	var actualPlayer dto.PlayerDTO
+
+	err := playerService.PatchPlayer(
+		context.Background(),
+		int(player.ID),
+		[]byte(`{"name":"PATCHED_PLAYER"}`),
+		&actualPlayer,
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "PATCHED_PLAYER", actualPlayer.Name)
+	assert.Equal(t, player.Avatar, actualPlayer.Avatar)
+	assert.Equal(t, player.TeamID, actualPlayer.TeamID)
+
+	repository.AssertExpectations(t)
+}
+
+func TestService_PatchPlayer_RosterMove(t *testing.T) {
+	player := models.Player{
+		ID:     1,
+		Name:   "TEST_PLAYER",
+		TeamID: intPtr(1),
+		Status: models.PlayerActive,
+	}
+
+	repository := &mocks.Repository{}
+	repository.On("FindById", mock.AnythingOfType("*models.Player"), mock.AnythingOfType("int")).
+		Run(func(args mock.Arguments) {
+			arg := args.Get(0).(*models.Player)
+
+			*arg = player
+		}).
+		Return(nil)
+
+	dbService := &db.DB{Repository: repository}
+	playerService := New(dbService, nil, team.New(dbService, nil))
+	var actualPlayer dto.PlayerDTO
+
+	err := playerService.PatchPlayer(
+		context.Background(),
+		int(player.ID),
+		[]byte(`{"team_id":2,"status":"injured_reserve"}`),
+		&actualPlayer,
+	)
+
+	assert.Equal(t, validation.Errors{
+		{Field: "team_id", Code: CodeTransactionRequired},
+		{Field: "status", Code: CodeTransactionRequired},
+	}, err)
+
+	repository.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestService_CreatePlayer_JerseyNumberTaken(t *testing.T) {
+	jerseyNumber := 12
+	teammate := models.Player{
+		ID:           1,
+		Name:         "TEST_TEAMMATE",
+		TeamID:       intPtr(1),
+		JerseyNumber: &jerseyNumber,
+		Status:       models.PlayerActive,
+	}
+
+	repository := &mocks.Repository{}
+	repository.On("FindById", mock.AnythingOfType("*models.Team"), 1).
+		Return(nil)
+
+	playerRepository := &mocks.PlayerRepository{}
+	playerRepository.On("FindActiveByJerseyNumber", 1, jerseyNumber, mock.AnythingOfType("*models.Player")).
+		Run(func(args mock.Arguments) {
+			arg := args.Get(2).(*models.Player)
+
+			*arg = teammate
+		}).
+		Return(nil)
+
+	dbService := &db.DB{Repository: repository, PlayerRepository: playerRepository}
+	playerService := New(dbService, nil, team.New(dbService, nil))
+
+	player := dto.PlayerDTO{
+		Name:         "TEST_PLAYER",
+		TeamID:       intPtr(1),
+		JerseyNumber: &jerseyNumber,
+		Status:       models.PlayerActive,
+	}
+
+	err := playerService.CreatePlayer(context.Background(), &player)
+
+	assert.Equal(t, validation.Errors{{Field: "jersey_number", Code: validation.CodeTaken}}, err)
+
+	repository.AssertExpectations(t)
+	playerRepository.AssertExpectations(t)
+}
@@ -0,0 +1,59 @@
+package player
+
+import (
+	"context"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+	"time"
+)
+
+const (
+	maxLength     = 255
+	maxJersey     = 99
+	maxHeight     = 100
+	maxWeight     = 500
+	maxExperience = 30
+)
+
+// Validate profile of the player, it is shared with the draft creating players
+func ValidatePlayer(player dto.PlayerDTO) error {
+	errs := validation.New().
+		Required("name", player.Name).
+		MaxLength("name", player.Name, maxLength).
+		MaxLength("avatar", player.Avatar, maxLength).
+		Range("height", player.Height, 0, maxHeight).
+		Range("weight", player.Weight, 0, maxWeight).
+		MaxLength("college", player.College, maxLength).
+		Range("experience", player.Experience, 0, maxExperience).
+		OneOf("status", player.Status, models.PlayerStatuses...)
+
+	// Free agents have no team, position and jersey number may be unknown until the player is assigned
+	if player.TeamID != nil {
+		errs.ID("team_id", *player.TeamID)
+	}
+
+	if player.Position != "" {
+		errs.OneOf("position", player.Position, models.Positions...)
+	}
+
+	if player.JerseyNumber != nil {
+		errs.Range("jersey_number", *player.JerseyNumber, 0, maxJersey)
+	}
+
+	if player.BirthDate != "" {
+		birthDate, err := time.Parse(models.DateFormat, player.BirthDate)
+
+		errs.Check(err == nil && birthDate.Before(time.Now()), "birth_date", validation.CodeInvalid)
+	}
+
+	return errs.Err()
+}
+
+func (r createPlayerRequest) Validate(_ context.Context) error {
+	return ValidatePlayer(r.Player)
+}
+
+func (r updatePlayerRequest) Validate(_ context.Context) error {
+	return ValidatePlayer(r.Player)
+}
@@ -0,0 +1,47 @@
+package query
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Include is a set of relations requested with ?include=a,b
+type Include map[string]bool
+
+const includeParam = "include"
+
+func NewInclude(params url.Values, allowed ...string) (Include, error) {
+	include := Include{}
+	value := params.Get(includeParam)
+
+	if value == "" {
+		return include, nil
+	}
+
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+
+		if !contains(allowed, name) {
+			return nil, InvalidParam(includeParam, value, fmt.Sprintf("can't include %q", name))
+		}
+
+		include[name] = true
+	}
+
+	return include, nil
+}
+
+func (i Include) Has(name string) bool {
+	return i[name]
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
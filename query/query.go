@@ -0,0 +1,207 @@
+package query
+
+import (
+	"fmt"
+	"github.com/jinzhu/gorm"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type Operator int
+
+const (
+	// Field can't be used as filter
+	None Operator = iota
+	// Exact match of the value
+	Equal
+	// Case insensitive substring match
+	Contains
+)
+
+type Type int
+
+const (
+	String Type = iota
+	Int
+)
+
+// Field describes column exposed to the query string
+type Field struct {
+	Column   string
+	Type     Type
+	Filter   Operator
+	Sortable bool
+	// Column may be NULL, NULLs are sorted last in both directions
+	Nullable bool
+}
+
+// Fields is a whitelist of query parameters allowed for a resource
+type Fields map[string]Field
+
+type Filter struct {
+	Column   string
+	Operator Operator
+	Value    interface{}
+}
+
+type Sort struct {
+	Column   string
+	Desc     bool
+	Nullable bool
+}
+
+type Query struct {
+	Filters []Filter
+	Sort    []Sort
+	// Associations loaded with additional batched query
+	Preload []string
+}
+
+// Error about invalid query parameter
+func InvalidParam(param, value, reason string) error {
+	return &apperrors.Error{
+		Kind:    apperrors.KindInvalidArgument,
+		Message: fmt.Sprintf("invalid query parameter %s=%q: %s", param, value, reason),
+		Details: map[string]string{"param": param, "value": value},
+	}
+}
+
+const sortParam = "sort"
+
+func New(params url.Values, fields Fields) (Query, error) {
+	q := Query{}
+
+	for name, field := range fields {
+		if field.Filter == None {
+			continue
+		}
+
+		value := params.Get(name)
+
+		if value == "" {
+			continue
+		}
+
+		filter, err := parseFilter(name, value, field)
+
+		if err != nil {
+			return Query{}, err
+		}
+
+		q.Filters = append(q.Filters, filter)
+	}
+
+	if value := params.Get(sortParam); value != "" {
+		sort, err := parseSort(value, fields)
+
+		if err != nil {
+			return Query{}, err
+		}
+
+		q.Sort = sort
+	}
+
+	return q, nil
+}
+
+func parseFilter(name, value string, field Field) (Filter, error) {
+	filter := Filter{Column: field.Column, Operator: field.Filter, Value: value}
+
+	if field.Type == Int {
+		v, err := strconv.Atoi(value)
+
+		if err != nil {
+			return Filter{}, InvalidParam(name, value, "must be an integer")
+		}
+
+		filter.Value = v
+	}
+
+	return filter, nil
+}
+
+func parseSort(value string, fields Fields) ([]Sort, error) {
+	var sort []Sort
+
+	for _, key := range strings.Split(value, ",") {
+		key = strings.TrimSpace(key)
+		desc := strings.HasPrefix(key, "-")
+		name := strings.TrimPrefix(key, "-")
+
+		field, ok := fields[name]
+
+		if !ok || !field.Sortable {
+			return nil, InvalidParam(sortParam, value, fmt.Sprintf("can't sort by %q", name))
+		}
+
+		sort = append(sort, Sort{Column: field.Column, Desc: desc, Nullable: field.Nullable})
+	}
+
+	return sort, nil
+}
+
+// Apply filters, ordering and preloading to the statement, rows are always ordered by id last
+func (q Query) Apply(db *gorm.DB) *gorm.DB {
+	return q.Preloads(q.Order(q.Where(db)))
+}
+
+// Apply preloading of associations only
+func (q Query) Preloads(db *gorm.DB) *gorm.DB {
+	for _, association := range q.Preload {
+		db = db.Preload(association)
+	}
+
+	return db
+}
+
+// Apply filters only
+func (q Query) Where(db *gorm.DB) *gorm.DB {
+	for _, filter := range q.Filters {
+		switch filter.Operator {
+		case Equal:
+			db = db.Where(fmt.Sprintf("%s = ?", filter.Column), filter.Value)
+		case Contains:
+			db = db.Where(fmt.Sprintf("%s ILIKE ?", filter.Column), "%"+escapeLike(fmt.Sprint(filter.Value))+"%")
+		}
+	}
+
+	return db
+}
+
+// Apply ordering only
+func (q Query) Order(db *gorm.DB) *gorm.DB {
+	for _, sort := range q.Sort {
+		db = db.Order(sort.Clause())
+	}
+
+	return db.Order("id ASC")
+}
+
+func (s Sort) String() string {
+	if s.Desc {
+		return "-" + s.Column
+	}
+
+	return s.Column
+}
+
+// ORDER BY clause of the sort key
+func (s Sort) Clause() string {
+	clause := s.Column + " ASC"
+
+	if s.Desc {
+		clause = s.Column + " DESC"
+	}
+
+	if s.Nullable {
+		clause += " NULLS LAST"
+	}
+
+	return clause
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
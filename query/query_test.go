@@ -0,0 +1,74 @@
+package query
+
+import (
+	"errors"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/stretchr/testify/assert"
+	"net/url"
+	"testing"
+)
+
+var testFields = Fields{
+	"team_id":       {Column: "team_id", Type: Int, Filter: Equal},
+	"name":          {Column: "name", Filter: Contains, Sortable: true},
+	"created_at":    {Column: "created_at", Sortable: true},
+	"jersey_number": {Column: "jersey_number", Type: Int, Sortable: true, Nullable: true},
+}
+
+func TestNew(t *testing.T) {
+	values := url.Values{
+		"team_id": []string{"3"},
+		"name":    []string{"brady"},
+		"sort":    []string{"-created_at,name"},
+		"page":    []string{"1"},
+	}
+
+	q, err := New(values, testFields)
+
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []Filter{
+		{Column: "team_id", Operator: Equal, Value: 3},
+		{Column: "name", Operator: Contains, Value: "brady"},
+	}, q.Filters)
+	assert.Equal(t, []Sort{
+		{Column: "created_at", Desc: true},
+		{Column: "name", Desc: false},
+	}, q.Sort)
+}
+
+func TestSort_Clause(t *testing.T) {
+	q, err := New(url.Values{"sort": []string{"-jersey_number,name"}}, testFields)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "jersey_number DESC NULLS LAST", q.Sort[0].Clause())
+	assert.Equal(t, "name ASC", q.Sort[1].Clause())
+}
+
+func TestNew_InvalidParams(t *testing.T) {
+	cases := []url.Values{
+		{"sort": []string{"password"}},
+		{"sort": []string{"team_id"}},
+		{"team_id": []string{"three"}},
+	}
+
+	for _, values := range cases {
+		_, err := New(values, testFields)
+
+		assert.True(t, errors.Is(err, apperrors.ErrInvalidArgument))
+	}
+}
+
+func TestEscapeLike(t *testing.T) {
+	assert.Equal(t, `50\%\_off\\`, escapeLike(`50%_off\`))
+}
+
+func TestNewInclude(t *testing.T) {
+	include, err := NewInclude(url.Values{"include": []string{"team"}}, "team")
+
+	assert.Nil(t, err)
+	assert.True(t, include.Has("team"))
+
+	_, err = NewInclude(url.Values{"include": []string{"team,contracts"}}, "team")
+
+	assert.True(t, errors.Is(err, apperrors.ErrInvalidArgument))
+}
@@ -0,0 +1,213 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/go-kit/kit/log"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/router"
+	"math"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Class of requests, every class has its own limit and bucket
+type Class string
+
+const (
+	ClassRead   Class = "read"
+	ClassWrite  Class = "write"
+	ClassUpload Class = "upload"
+)
+
+type Limits map[Class]Limit
+
+// Requests per minute used when RATE_LIMIT_READ, RATE_LIMIT_WRITE or RATE_LIMIT_UPLOAD isn't configured
+var defaultLimits = map[Class]int{
+	ClassRead:   600,
+	ClassWrite:  120,
+	ClassUpload: 10,
+}
+
+// KeyFunc identifies the client, requests with the same key share buckets. Clients which can't be
+// identified cheaply are returned with verify, the request is counted against the key first and verify
+// is called only when it fits in, verified requests are counted against the returned key as well.
+type KeyFunc func(r *http.Request) (key string, verify func() (string, bool))
+
+type Limiter struct {
+	Store  Store
+	Limits Limits
+	Key    KeyFunc
+	Logger log.Logger
+}
+
+// Limiter keeping buckets in memory with limits per minute read from environment, zero disables
+// limiting of the class
+func New(key KeyFunc, logger log.Logger) *Limiter {
+	limits := Limits{}
+
+	for class, perMinute := range defaultLimits {
+		if n, err := strconv.Atoi(os.Getenv("RATE_LIMIT_" + strings.ToUpper(string(class)))); err == nil && n >= 0 {
+			perMinute = n
+		}
+
+		limits[class] = Limit{Burst: perMinute, Period: time.Minute}
+	}
+
+	return NewWithStore(NewMemoryStore(), limits, key, logger)
+}
+
+func NewWithStore(store Store, limits Limits, key KeyFunc, logger log.Logger) *Limiter {
+	return &Limiter{Store: store, Limits: limits, Key: key, Logger: logger}
+}
+
+// Reads are safe methods, writes with multipart body are uploads
+func Classify(r *http.Request) Class {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ClassRead
+	}
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		return ClassUpload
+	}
+
+	return ClassWrite
+}
+
+// Middleware rejects requests over the limit with 429 before they are routed, so upload bodies aren't
+// read. Every limited response has RateLimit-* headers. Requests are let through when the store fails.
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		class := Classify(r)
+		limit, ok := l.Limits[class]
+
+		if !ok || limit.Burst <= 0 {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		key, verify := l.Key(r)
+
+		if !l.take(w, r, class, key, limit) {
+			return
+		}
+
+		if verify != nil {
+			if key, ok := verify(); ok && !l.take(w, r, class, key, limit) {
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Take from the bucket of the key and set RateLimit-* headers, rejected requests are answered with 429
+func (l *Limiter) take(w http.ResponseWriter, r *http.Request, class Class, key string, limit Limit) bool {
+	result, err := l.Store.Take(r.Context(), string(class)+":"+key, limit)
+
+	if err != nil {
+		l.Logger.Log("ratelimit", err)
+
+		return true
+	}
+
+	h := w.Header()
+	h.Set("RateLimit-Limit", strconv.Itoa(limit.Burst))
+	h.Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	h.Set("RateLimit-Reset", ceilSeconds(result.Reset))
+	h.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%s", limit.Burst, ceilSeconds(limit.Period)))
+
+	if !result.Allowed {
+		h.Set("Retry-After", ceilSeconds(result.RetryAfter))
+		router.EncodeError(r.Context(), apperrors.ErrRateLimited, w)
+
+		return false
+	}
+
+	return true
+}
+
+// How long verified API keys are keyed by their hash without asking verify again
+const verifiedTTL = time.Minute
+
+// ByAPIKey keys requests with API keys accepted by verify by hash of the key and other requests by
+// client IP, which is taken from X-Forwarded-For of the proxies only. Keys are verified, otherwise clients would get a fresh bucket with every made up key.
+// Until the key is verified its requests are counted against the client IP, so verify isn't called
+// once the IP is over the limit.
+func ByAPIKey(proxies Proxies, verify func(ctx context.Context, authorization string) bool) KeyFunc {
+	verified := newVerifiedKeys(verifiedTTL)
+
+	return func(r *http.Request) (string, func() (string, bool)) {
+		ip := "ip:" + proxies.ClientIP(r)
+		authorization := r.Header.Get("Authorization")
+		parts := strings.SplitN(strings.TrimSpace(authorization), " ", 2)
+
+		if len(parts) != 2 || !strings.EqualFold(parts[0], "ApiKey") {
+			return ip, nil
+		}
+
+		sum := sha256.Sum256([]byte(strings.TrimSpace(parts[1])))
+		key := "key:" + hex.EncodeToString(sum[:])
+
+		if verified.Has(key, time.Now()) {
+			return key, nil
+		}
+
+		return ip, func() (string, bool) {
+			if !verify(r.Context(), authorization) {
+				return "", false
+			}
+
+			verified.Add(key, time.Now())
+
+			return key, true
+		}
+	}
+}
+
+// Hashes of verified API keys, only valid keys are kept so the set is bounded by keys issued
+type verifiedKeys struct {
+	ttl     time.Duration
+	mtx     sync.Mutex
+	expires map[string]time.Time
+}
+
+func newVerifiedKeys(ttl time.Duration) *verifiedKeys {
+	return &verifiedKeys{ttl: ttl, expires: map[string]time.Time{}}
+}
+
+func (v *verifiedKeys) Has(key string, now time.Time) bool {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	expires, ok := v.expires[key]
+
+	return ok && now.Before(expires)
+}
+
+// Add the key and drop expired ones, keys are added at most once per TTL
+func (v *verifiedKeys) Add(key string, now time.Time) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	for k, expires := range v.expires {
+		if !now.Before(expires) {
+			delete(v.expires, k)
+		}
+	}
+
+	v.expires[key] = now.Add(v.ttl)
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
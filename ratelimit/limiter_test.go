@@ -0,0 +1,84 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/go-kit/kit/log"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestLimiter_Middleware(t *testing.T) {
+	limits := Limits{
+		ClassRead:   {Burst: 2, Period: time.Minute},
+		ClassWrite:  {Burst: 1, Period: time.Minute},
+		ClassUpload: {Burst: 0, Period: time.Minute},
+	}
+	verified := 0
+	verify := func(_ context.Context, authorization string) bool {
+		verified++
+
+		return authorization == "ApiKey valid"
+	}
+	remoteAddr := "192.0.2.1:1234"
+
+	limiter := NewWithStore(NewMemoryStore(), limits, ByAPIKey(nil, verify), log.NewNopLogger())
+	handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(method, authorization, contentType string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "/players", nil)
+		r.RemoteAddr = remoteAddr
+		r.Header.Set("Authorization", authorization)
+		r.Header.Set("Content-Type", contentType)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		return w
+	}
+
+	w := serve(http.MethodGet, "", "")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "2", w.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "1", w.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "30", w.Header().Get("RateLimit-Reset"))
+	assert.Equal(t, "2;w=60", w.Header().Get("RateLimit-Policy"))
+
+	// Writes don't take from reads
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "", "application/json").Code)
+	assert.Equal(t, http.StatusTooManyRequests, serve(http.MethodPut, "", "application/json").Code)
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "", "").Code)
+
+	w = serve(http.MethodGet, "ApiKey forged", "")
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "30", w.Header().Get("Retry-After"))
+
+	// Keys aren't verified over the limit of the client IP
+	assert.Equal(t, http.StatusTooManyRequests, serve(http.MethodGet, "ApiKey valid", "").Code)
+	assert.Equal(t, 0, verified)
+
+	remoteAddr = "192.0.2.2:1234"
+
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "ApiKey valid", "").Code)
+	assert.Equal(t, 1, verified)
+
+	// Verified keys have their own buckets
+	remoteAddr = "192.0.2.1:1234"
+	w = serve(http.MethodGet, "ApiKey valid", "")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "0", w.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, 1, verified)
+
+	// Uploads aren't limited
+	w = serve(http.MethodPut, "", "multipart/form-data; boundary=x")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("RateLimit-Limit"))
+}
@@ -0,0 +1,47 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	now := time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+
+	limit := Limit{Burst: 2, Period: 10 * time.Second}
+	ctx := context.Background()
+
+	result, _ := store.Take(ctx, "a", limit)
+
+	assert.Equal(t, Result{Allowed: true, Remaining: 1, Reset: 5 * time.Second}, result)
+
+	result, _ = store.Take(ctx, "a", limit)
+
+	assert.Equal(t, Result{Allowed: true, Remaining: 0, Reset: 10 * time.Second}, result)
+
+	result, _ = store.Take(ctx, "a", limit)
+
+	assert.Equal(t, Result{Allowed: false, Remaining: 0, Reset: 10 * time.Second, RetryAfter: 5 * time.Second}, result)
+
+	// Other clients have their own buckets
+	result, _ = store.Take(ctx, "b", limit)
+
+	assert.True(t, result.Allowed)
+
+	now = now.Add(5 * time.Second)
+
+	result, _ = store.Take(ctx, "a", limit)
+
+	assert.Equal(t, Result{Allowed: true, Remaining: 0, Reset: 10 * time.Second}, result)
+
+	// Full buckets are swept
+	now = now.Add(time.Hour)
+
+	store.Take(ctx, "c", limit)
+
+	assert.Len(t, store.buckets, 1)
+}
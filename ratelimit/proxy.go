@@ -0,0 +1,106 @@
+package ratelimit
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Proxies in front of the server whose X-Forwarded-For header is trusted
+type Proxies []*net.IPNet
+
+// Proxies listed in RATE_LIMIT_TRUSTED_PROXIES, none are trusted when it isn't configured
+func TrustedProxies() (Proxies, error) {
+	return ParseProxies(os.Getenv("RATE_LIMIT_TRUSTED_PROXIES"))
+}
+
+// Parse comma separated IP addresses and CIDR ranges
+func ParseProxies(s string) (Proxies, error) {
+	var proxies Proxies
+
+	for _, value := range strings.Split(s, ",") {
+		value = strings.TrimSpace(value)
+
+		if value == "" {
+			continue
+		}
+
+		if !strings.Contains(value, "/") {
+			ip := net.ParseIP(value)
+
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", value)
+			}
+
+			bits := 8 * net.IPv6len
+
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(value)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", value)
+		}
+
+		proxies = append(proxies, ipNet)
+	}
+
+	return proxies, nil
+}
+
+func (p Proxies) trusted(addr string) bool {
+	ip := net.ParseIP(addr)
+
+	if ip == nil {
+		return false
+	}
+
+	for _, ipNet := range p {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ClientIP of the request. X-Forwarded-For is used only for requests from trusted proxies, the client is
+// its rightmost address which isn't a trusted proxy since addresses left of it can be set by anyone.
+func (p Proxies) ClientIP(r *http.Request) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	if !p.trusted(ip) {
+		return ip
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header["X-Forwarded-For"], ","), ",")
+
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr := strings.TrimSpace(forwarded[i])
+
+		if net.ParseIP(addr) == nil {
+			break
+		}
+
+		ip = addr
+
+		if !p.trusted(addr) {
+			break
+		}
+	}
+
+	return ip
+}
@@ -0,0 +1,48 @@
+package ratelimit
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProxies_ClientIP(t *testing.T) {
+	proxies, err := ParseProxies("10.0.0.0/8, 192.0.2.10")
+
+	assert.Nil(t, err)
+
+	cases := []struct {
+		remoteAddr string
+		forwarded  string
+		expected   string
+	}{
+		// Clients can't pick their IP without a trusted proxy
+		{"198.51.100.1:1234", "203.0.113.1", "198.51.100.1"},
+		{"192.0.2.10:1234", "203.0.113.1", "203.0.113.1"},
+		// Addresses added by the client in front of the proxies are ignored
+		{"10.0.0.1:1234", "203.0.113.9, 203.0.113.1, 10.0.0.2", "203.0.113.1"},
+		{"10.0.0.1:1234", "", "10.0.0.1"},
+		{"10.0.0.1:1234", "not-an-ip", "10.0.0.1"},
+	}
+
+	for _, c := range cases {
+		r := httptest.NewRequest(http.MethodGet, "/players", nil)
+		r.RemoteAddr = c.remoteAddr
+
+		if c.forwarded != "" {
+			r.Header.Set("X-Forwarded-For", c.forwarded)
+		}
+
+		assert.Equal(t, c.expected, proxies.ClientIP(r), c.remoteAddr+" "+c.forwarded)
+	}
+
+	_, err = ParseProxies("10.0.0.0/33")
+
+	assert.NotNil(t, err)
+
+	proxies, err = ParseProxies("")
+
+	assert.Nil(t, err)
+	assert.Empty(t, proxies)
+}
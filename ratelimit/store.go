@@ -0,0 +1,34 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Store keeps token buckets of clients. The in-memory store limits every process on its own, a shared
+// store is needed to limit clients across replicas.
+type Store interface {
+	// Take one token from the bucket of the key, the bucket is full when it is seen for the first time
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// Limit is a token bucket holding up to Burst tokens which is refilled completely during Period
+type Limit struct {
+	Burst  int
+	Period time.Duration
+}
+
+// Tokens added to the bucket every second
+func (l Limit) rate() float64 {
+	return float64(l.Burst) / l.Period.Seconds()
+}
+
+// Result of taking a token
+type Result struct {
+	Allowed   bool
+	Remaining int
+	// Time until the bucket is full again
+	Reset time.Duration
+	// Time until the next token is available, zero for allowed requests
+	RetryAfter time.Duration
+}
@@ -0,0 +1,124 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/utils"
+	"github.com/logansua/nfl_app/validation"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Common options of go-kit HTTP servers
+func ServerOptions(logger log.Logger) []httptransport.ServerOption {
+	return []httptransport.ServerOption{
+		httptransport.ServerErrorLogger(logger),
+		httptransport.ServerErrorEncoder(EncodeError),
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+		httptransport.ServerAfter(func(ctx context.Context, writer http.ResponseWriter) context.Context {
+			writer.Header().Set("Content-type", "application/json")
+
+			return ctx
+		}),
+		httptransport.ServerFinalizer(func(ctx context.Context, code int, r *http.Request) {
+			logger.Log("METHOD", r.Method, "PATH", r.URL.Path, "CODE", code)
+		}),
+	}
+}
+
+type errorer interface {
+	error() error
+}
+
+func EncodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		// Not a Go kit transport error, but a business-logic error.
+		// Provide those as HTTP errors.
+		EncodeError(ctx, e.error(), w)
+
+		return nil
+	}
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// Encode listing response with Link header
+func EncodePaginatedResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if resp, ok := response.(utils.PaginatedResponse); ok {
+		requestURI, _ := ctx.Value(httptransport.ContextKeyRequestURI).(string)
+
+		if u, err := url.Parse(requestURI); err == nil {
+			w.Header().Set("Link", resp.Meta.Link(*u))
+		}
+	}
+
+	return EncodeResponse(ctx, w, response)
+}
+
+func EncodeNoContentResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		EncodeError(ctx, e.error(), w)
+
+		return nil
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return nil
+}
+
+// Encode error with HTTP status code of its kind, internal causes are not exposed to the client
+func EncodeError(_ context.Context, err error, w http.ResponseWriter) {
+	if err == nil {
+		panic("encodeError with nil error")
+	}
+
+	w.Header().Set("Content-type", "application/json")
+
+	var fields validation.Errors
+
+	if errors.As(err, &fields) {
+		w.WriteHeader(apperrors.KindValidation.Status())
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"errors": fields,
+		})
+
+		return
+	}
+
+	e := apperrors.From(classify(err))
+
+	if e.Kind == apperrors.KindUnauthenticated {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+
+	w.WriteHeader(e.Kind.Status())
+
+	body := map[string]interface{}{
+		"error": e.Message,
+	}
+
+	if e.Details != nil {
+		body["details"] = e.Details
+	}
+
+	json.NewEncoder(w).Encode(body)
+}
+
+// Errors of request decoding are caused by the client
+func classify(err error) error {
+	var syntaxErr *json.SyntaxError
+	var numErr *strconv.NumError
+
+	if errors.As(err, &syntaxErr) || errors.As(err, &numErr) {
+		return apperrors.Wrap(apperrors.KindInvalidArgument, err.Error(), err)
+	}
+
+	return err
+}
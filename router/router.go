@@ -9,6 +9,7 @@ type Route struct {
 	Name        string
 	Method      string
 	Path        string
+	PathPrefix  bool
 	StrictSlash bool
 	Handler     http.Handler
 }
@@ -18,18 +19,12 @@ func New(routes []Route) *mux.Router {
 
 	for _, route := range routes {
 		// Register defined route
-		router.
-			StrictSlash(route.StrictSlash).
-			Methods(route.Method).
-			Path(route.Path).
+		matchPath(router.StrictSlash(route.StrictSlash).Methods(route.Method), route).
 			Name(route.Name).
 			Handler(route.Handler)
 
 		// Register route with method OPTIONS
-		router.
-			StrictSlash(route.StrictSlash).
-			Methods(http.MethodOptions).
-			Path(route.Path).
+		matchPath(router.StrictSlash(route.StrictSlash).Methods(http.MethodOptions), route).
 			Name(route.Name).
 			Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 				w.WriteHeader(http.StatusOK)
@@ -41,6 +36,14 @@ func New(routes []Route) *mux.Router {
 	return router
 }
 
+func matchPath(r *mux.Route, route Route) *mux.Route {
+	if route.PathPrefix {
+		return r.PathPrefix(route.Path)
+	}
+
+	return r.Path(route.Path)
+}
+
 func makeSwaggerHandler(r *mux.Router) {
 	const docsPath = "/docs"
 
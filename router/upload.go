@@ -0,0 +1,27 @@
+package router
+
+import (
+	"errors"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"net/http"
+)
+
+// ParseMultipartForm reads at most maxSize bytes of the body. Bodies over the limit are too large,
+// bodies which aren't valid multipart forms are invalid arguments.
+func ParseMultipartForm(r *http.Request, maxSize int64) error {
+	r.Body = http.MaxBytesReader(nil, r.Body, maxSize)
+
+	err := r.ParseMultipartForm(maxSize)
+
+	if err == nil {
+		return nil
+	}
+
+	var tooLarge *http.MaxBytesError
+
+	if errors.As(err, &tooLarge) {
+		return apperrors.Wrap(apperrors.KindTooLarge, "file is too big", err)
+	}
+
+	return apperrors.Wrap(apperrors.KindInvalidArgument, "invalid multipart form", err)
+}
@@ -0,0 +1,53 @@
+package router
+
+import (
+	"bytes"
+	"errors"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/stretchr/testify/assert"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newUploadRequest(t *testing.T, size int) *http.Request {
+	var body bytes.Buffer
+
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("image", "avatar.png")
+
+	assert.Nil(t, err)
+
+	part.Write(bytes.Repeat([]byte{'a'}, size))
+	w.Close()
+
+	r := httptest.NewRequest(http.MethodPut, "/players/1/avatar", &body)
+	r.Header.Set("Content-Type", w.FormDataContentType())
+
+	return r
+}
+
+func TestParseMultipartForm(t *testing.T) {
+	const maxSize = 1024
+
+	r := newUploadRequest(t, 100)
+
+	assert.Nil(t, ParseMultipartForm(r, maxSize))
+
+	_, _, err := r.FormFile("image")
+
+	assert.Nil(t, err)
+
+	err = ParseMultipartForm(newUploadRequest(t, 2*maxSize), maxSize)
+
+	assert.Equal(t, apperrors.KindTooLarge, apperrors.From(err).Kind)
+
+	r = httptest.NewRequest(http.MethodPut, "/players/1/avatar", strings.NewReader(`{"image":""}`))
+	r.Header.Set("Content-Type", "application/json")
+
+	err = ParseMultipartForm(r, maxSize)
+
+	assert.True(t, errors.Is(err, apperrors.ErrInvalidArgument))
+}
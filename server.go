@@ -0,0 +1,41 @@
+package main
+
+import (
+	"net/http"
+	"os"
+	"time"
+)
+
+// Timeouts used when HTTP_READ_HEADER_TIMEOUT, HTTP_READ_TIMEOUT, HTTP_WRITE_TIMEOUT, HTTP_IDLE_TIMEOUT
+// or HTTP_SHUTDOWN_TIMEOUT isn't configured
+const (
+	defaultReadHeaderTimeout = 5 * time.Second
+	defaultReadTimeout       = 30 * time.Second
+	defaultWriteTimeout      = 30 * time.Second
+	defaultIdleTimeout       = 2 * time.Minute
+	defaultShutdownTimeout   = 20 * time.Second
+)
+
+// HTTP server with timeouts read from environment. Read timeout covers upload bodies, write timeout
+// is lifted by streaming handlers.
+func newServer(addr string, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: envDuration("HTTP_READ_HEADER_TIMEOUT", defaultReadHeaderTimeout),
+		ReadTimeout:       envDuration("HTTP_READ_TIMEOUT", defaultReadTimeout),
+		WriteTimeout:      envDuration("HTTP_WRITE_TIMEOUT", defaultWriteTimeout),
+		IdleTimeout:       envDuration("HTTP_IDLE_TIMEOUT", defaultIdleTimeout),
+	}
+}
+
+// Duration like "30s" from environment, invalid values fall back to the default
+func envDuration(name string, fallback time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(name))
+
+	if err != nil || d < 0 {
+		return fallback
+	}
+
+	return d
+}
@@ -0,0 +1,52 @@
+package standings
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/utils"
+)
+
+type Endpoints struct {
+	GetStandingsEndpoint endpoint.Endpoint
+}
+
+func MakeServerEndpoints(s Service, authenticate endpoint.Middleware) Endpoints {
+	return Endpoints{
+		GetStandingsEndpoint: authenticate(MakeGetStandingsEndpoint(s)),
+	}
+}
+
+func (e Endpoints) GetStandings(ctx context.Context, year int, groupBy string) error {
+	request := getStandingsRequest{year: year, GroupBy: groupBy}
+	response, err := e.GetStandingsEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+
+func MakeGetStandingsEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getStandingsRequest)
+
+		var groups []dto.StandingsGroupDTO
+
+		err = service.GetStandings(ctx, req.year, req.GroupBy, &groups)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: groups}, nil
+	}
+}
+
+type getStandingsRequest struct {
+	year    int
+	GroupBy string
+}
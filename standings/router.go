@@ -0,0 +1,76 @@
+package standings
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/logansua/nfl_app/query"
+	"github.com/logansua/nfl_app/router"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const groupByParam = "group_by"
+
+func GetServiceOptions(logger log.Logger) []httptransport.ServerOption {
+	return router.ServerOptions(logger)
+}
+
+func CreateRoutes(s Service, logger log.Logger, authenticate endpoint.Middleware) []router.Route {
+	endpoints := MakeServerEndpoints(s, authenticate)
+
+	options := GetServiceOptions(logger)
+
+	return []router.Route{
+		{
+			Name:        "Get season standings",
+			Method:      http.MethodGet,
+			Path:        "/seasons/{year}/standings",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetStandingsEndpoint,
+				decodeGetStandingsRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+	}
+}
+
+func decodeGetStandingsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req getStandingsRequest
+
+	year, err := strconv.Atoi(mux.Vars(r)["year"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	groupBy := r.URL.Query().Get(groupByParam)
+
+	if groupBy == "" {
+		groupBy = GroupDivision
+	}
+
+	if !isGrouping(groupBy) {
+		return nil, query.InvalidParam(groupByParam, groupBy, "must be one of "+strings.Join(Groupings, ", "))
+	}
+
+	req.year = year
+	req.GroupBy = groupBy
+
+	return req, nil
+}
+
+func isGrouping(value string) bool {
+	for _, grouping := range Groupings {
+		if value == grouping {
+			return true
+		}
+	}
+
+	return false
+}
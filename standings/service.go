@@ -0,0 +1,124 @@
+package standings
+
+import (
+	"context"
+	"github.com/logansua/nfl_app/db"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"math"
+)
+
+// Service computes league standings from final game results.
+type Service interface {
+	// Get standings of the season grouped by league, conference or division
+	GetStandings(ctx context.Context, year int, groupBy string, groups *[]dto.StandingsGroupDTO) error
+}
+
+type service struct {
+	DB *db.DB
+}
+
+func New(dbService *db.DB) Service {
+	return &service{DB: dbService}
+}
+
+func (s *service) GetStandings(ctx context.Context, year int, groupBy string, groups *[]dto.StandingsGroupDTO) error {
+	var season models.Season
+
+	err := s.DB.GameRepository.FindSeasonByYear(year, &season)
+
+	if err != nil {
+		return err
+	}
+
+	var t []models.Team
+
+	err = s.DB.TeamRepository.FindAllWithDivisions(&t)
+
+	if err != nil {
+		return err
+	}
+
+	var g []models.Game
+
+	err = s.DB.GameRepository.FindSeasonResults(int(season.ID), &g)
+
+	if err != nil {
+		return err
+	}
+
+	teams := make([]Team, len(t))
+
+	for key, value := range t {
+		teams[key] = newTeam(value)
+	}
+
+	games := make([]Game, len(g))
+
+	for key, value := range g {
+		games[key] = newGame(value)
+	}
+
+	computed := Compute(teams, games, groupBy)
+
+	*groups = make([]dto.StandingsGroupDTO, len(computed))
+
+	for key, value := range computed {
+		(*groups)[key] = newGroupDTO(value)
+	}
+
+	return nil
+}
+
+func newTeam(team models.Team) Team {
+	return Team{
+		ID:         int(team.ID),
+		Name:       team.Name,
+		Conference: team.Division.Conference.Name,
+		Division:   team.Division.Name,
+	}
+}
+
+func newGame(game models.Game) Game {
+	return Game{
+		HomeTeamID: game.HomeTeamID,
+		AwayTeamID: game.AwayTeamID,
+		HomeScore:  game.HomeScore,
+		AwayScore:  game.AwayScore,
+		KickoffAt:  game.KickoffAt,
+	}
+}
+
+func newGroupDTO(group Group) dto.StandingsGroupDTO {
+	teams := make([]dto.StandingDTO, len(group.Standings))
+
+	for key, value := range group.Standings {
+		teams[key] = newStandingDTO(value)
+	}
+
+	return dto.StandingsGroupDTO{
+		Conference: group.Conference,
+		Division:   group.Division,
+		Teams:      teams,
+	}
+}
+
+func newStandingDTO(standing Standing) dto.StandingDTO {
+	return dto.StandingDTO{
+		Rank:          standing.Rank,
+		TeamID:        standing.ID,
+		Team:          standing.Name,
+		Wins:          standing.Overall.Wins,
+		Losses:        standing.Overall.Losses,
+		Ties:          standing.Overall.Ties,
+		WinPct:        math.Round(standing.Overall.Pct()*1000) / 1000,
+		PointsFor:     standing.PointsFor,
+		PointsAgainst: standing.PointsAgainst,
+		PointDiff:     standing.PointDiff(),
+		Home:          standing.Home.String(),
+		Away:          standing.Away.String(),
+		Division:      standing.DivisionRecord.String(),
+		Conference:    standing.ConferenceRecord.String(),
+		Streak:        standing.Streak(),
+	}
+}
@@ -0,0 +1,257 @@
+package standings
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Grouping of standings
+const (
+	GroupLeague     = "league"
+	GroupConference = "conference"
+	GroupDivision   = "division"
+)
+
+var Groupings = []string{GroupLeague, GroupConference, GroupDivision}
+
+// Minimal number of common games used as tiebreaker between clubs of different divisions
+const minCommonGames = 4
+
+type Team struct {
+	ID         int
+	Name       string
+	Conference string
+	Division   string
+}
+
+// Game is a final result of a game
+type Game struct {
+	HomeTeamID int
+	AwayTeamID int
+	HomeScore  int
+	AwayScore  int
+	KickoffAt  time.Time
+}
+
+type Record struct {
+	Wins   int
+	Losses int
+	Ties   int
+}
+
+// Win percentage, ties count as half a win
+func (r Record) Pct() float64 {
+	games := r.Wins + r.Losses + r.Ties
+
+	if games == 0 {
+		return 0
+	}
+
+	return (float64(r.Wins) + float64(r.Ties)/2) / float64(games)
+}
+
+func (r Record) String() string {
+	return fmt.Sprintf("%d-%d-%d", r.Wins, r.Losses, r.Ties)
+}
+
+func (r *Record) add(o Record) {
+	r.Wins += o.Wins
+	r.Losses += o.Losses
+	r.Ties += o.Ties
+}
+
+type Standing struct {
+	Team
+	Rank int
+
+	Overall          Record
+	Home             Record
+	Away             Record
+	DivisionRecord   Record
+	ConferenceRecord Record
+	PointsFor        int
+	PointsAgainst    int
+
+	streakResult string
+	streakLength int
+}
+
+func (s Standing) PointDiff() int {
+	return s.PointsFor - s.PointsAgainst
+}
+
+// Current streak, e.g. W3 or L1, empty before the first game
+func (s Standing) Streak() string {
+	if s.streakLength == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%s%d", s.streakResult, s.streakLength)
+}
+
+type Group struct {
+	Conference string
+	Division   string
+	Standings  []Standing
+}
+
+// result of a single game from the point of view of one team
+type result struct {
+	opponent      int
+	pointsFor     int
+	pointsAgainst int
+}
+
+func (r result) record() Record {
+	switch {
+	case r.pointsFor > r.pointsAgainst:
+		return Record{Wins: 1}
+	case r.pointsFor < r.pointsAgainst:
+		return Record{Losses: 1}
+	default:
+		return Record{Ties: 1}
+	}
+}
+
+type table struct {
+	teams    map[int]Team
+	results  map[int][]result
+	overall  map[int]Record
+	computed map[int]*Standing
+}
+
+// Compute standings from final games and split them into groups ordered with NFL tiebreakers
+func Compute(teams []Team, games []Game, groupBy string) []Group {
+	t := newTable(teams, games)
+
+	var groups []Group
+	index := map[string]int{}
+
+	for _, team := range teams {
+		conference, division := "", ""
+
+		switch groupBy {
+		case GroupConference:
+			conference = team.Conference
+		case GroupDivision:
+			conference, division = team.Conference, team.Division
+		}
+
+		key := conference + "/" + division
+
+		if _, ok := index[key]; !ok {
+			index[key] = len(groups)
+			groups = append(groups, Group{Conference: conference, Division: division})
+		}
+
+		group := &groups[index[key]]
+		group.Standings = append(group.Standings, *t.computed[team.ID])
+	}
+
+	for key := range groups {
+		ids := make([]int, len(groups[key].Standings))
+
+		for i, s := range groups[key].Standings {
+			ids[i] = s.ID
+		}
+
+		ordered := t.order(ids)
+
+		for i, id := range ordered {
+			standing := *t.computed[id]
+			standing.Rank = i + 1
+
+			groups[key].Standings[i] = standing
+		}
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if groups[i].Conference != groups[j].Conference {
+			return groups[i].Conference < groups[j].Conference
+		}
+
+		return groups[i].Division < groups[j].Division
+	})
+
+	return groups
+}
+
+func newTable(teams []Team, games []Game) *table {
+	t := &table{
+		teams:    map[int]Team{},
+		results:  map[int][]result{},
+		overall:  map[int]Record{},
+		computed: map[int]*Standing{},
+	}
+
+	for _, team := range teams {
+		t.teams[team.ID] = team
+		t.computed[team.ID] = &Standing{Team: team}
+	}
+
+	ordered := make([]Game, len(games))
+	copy(ordered, games)
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].KickoffAt.Before(ordered[j].KickoffAt)
+	})
+
+	for _, game := range ordered {
+		home, homeOk := t.computed[game.HomeTeamID]
+		away, awayOk := t.computed[game.AwayTeamID]
+
+		if !homeOk || !awayOk {
+			continue
+		}
+
+		homeResult := result{opponent: game.AwayTeamID, pointsFor: game.HomeScore, pointsAgainst: game.AwayScore}
+		awayResult := result{opponent: game.HomeTeamID, pointsFor: game.AwayScore, pointsAgainst: game.HomeScore}
+
+		t.apply(home, homeResult)
+		t.apply(away, awayResult)
+
+		home.Home.add(homeResult.record())
+		away.Away.add(awayResult.record())
+	}
+
+	for id, s := range t.computed {
+		t.overall[id] = s.Overall
+	}
+
+	return t
+}
+
+func (t *table) apply(s *Standing, r result) {
+	record := r.record()
+	opponent := t.teams[r.opponent]
+
+	t.results[s.ID] = append(t.results[s.ID], r)
+
+	s.Overall.add(record)
+	s.PointsFor += r.pointsFor
+	s.PointsAgainst += r.pointsAgainst
+
+	if s.Conference != "" && opponent.Conference == s.Conference {
+		s.ConferenceRecord.add(record)
+
+		if s.Division != "" && opponent.Division == s.Division {
+			s.DivisionRecord.add(record)
+		}
+	}
+
+	outcome := "T"
+
+	switch {
+	case record.Wins > 0:
+		outcome = "W"
+	case record.Losses > 0:
+		outcome = "L"
+	}
+
+	if s.streakResult != outcome {
+		s.streakResult, s.streakLength = outcome, 0
+	}
+
+	s.streakLength++
+}
@@ -0,0 +1,152 @@
+package standings
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var week = func(n int) time.Time {
+	return time.Date(2018, time.September, 6, 20, 0, 0, 0, time.UTC).AddDate(0, 0, 7*(n-1))
+}
+
+var testTeams = []Team{
+	{ID: 1, Name: "Patriots", Conference: "AFC", Division: "East"},
+	{ID: 2, Name: "Dolphins", Conference: "AFC", Division: "East"},
+	{ID: 3, Name: "Bills", Conference: "AFC", Division: "East"},
+	{ID: 4, Name: "Jets", Conference: "AFC", Division: "East"},
+	{ID: 5, Name: "Eagles", Conference: "NFC", Division: "East"},
+}
+
+var testGames = []Game{
+	{HomeTeamID: 1, AwayTeamID: 2, HomeScore: 21, AwayScore: 14, KickoffAt: week(1)},
+	{HomeTeamID: 3, AwayTeamID: 4, HomeScore: 10, AwayScore: 10, KickoffAt: week(1)},
+	{HomeTeamID: 2, AwayTeamID: 3, HomeScore: 24, AwayScore: 20, KickoffAt: week(2)},
+	{HomeTeamID: 4, AwayTeamID: 1, HomeScore: 3, AwayScore: 17, KickoffAt: week(2)},
+	{HomeTeamID: 3, AwayTeamID: 1, HomeScore: 27, AwayScore: 13, KickoffAt: week(3)},
+	{HomeTeamID: 2, AwayTeamID: 4, HomeScore: 30, AwayScore: 0, KickoffAt: week(3)},
+	{HomeTeamID: 5, AwayTeamID: 2, HomeScore: 7, AwayScore: 6, KickoffAt: week(4)},
+}
+
+func TestRecord_Pct(t *testing.T) {
+	assert.Equal(t, 0.0, Record{}.Pct())
+	assert.Equal(t, 0.5, Record{Wins: 1, Losses: 1, Ties: 1}.Pct())
+	assert.Equal(t, "1-1-1", Record{Wins: 1, Losses: 1, Ties: 1}.String())
+}
+
+func TestCompute(t *testing.T) {
+	groups := Compute(testTeams, testGames, GroupDivision)
+
+	assert.Len(t, groups, 2)
+	assert.Equal(t, "AFC", groups[0].Conference)
+	assert.Equal(t, "East", groups[0].Division)
+
+	var ids []int
+
+	for _, s := range groups[0].Standings {
+		ids = append(ids, s.ID)
+	}
+
+	// Patriots have the best record, Dolphins win head-to-head against Bills
+	assert.Equal(t, []int{1, 2, 3, 4}, ids)
+
+	patriots := groups[0].Standings[0]
+
+	assert.Equal(t, 1, patriots.Rank)
+	assert.Equal(t, Record{Wins: 2, Losses: 1}, patriots.Overall)
+	assert.Equal(t, Record{Wins: 1}, patriots.Home)
+	assert.Equal(t, Record{Wins: 1, Losses: 1}, patriots.Away)
+	assert.Equal(t, Record{Wins: 2, Losses: 1}, patriots.DivisionRecord)
+	assert.Equal(t, 51, patriots.PointsFor)
+	assert.Equal(t, 44, patriots.PointsAgainst)
+	assert.Equal(t, 7, patriots.PointDiff())
+	assert.Equal(t, "L1", patriots.Streak())
+
+	dolphins := groups[0].Standings[1]
+
+	assert.Equal(t, Record{Wins: 2, Losses: 2}, dolphins.Overall)
+	assert.Equal(t, Record{Wins: 2, Losses: 1}, dolphins.ConferenceRecord)
+	assert.Equal(t, "L1", dolphins.Streak())
+
+	assert.Equal(t, "W1", groups[0].Standings[2].Streak())
+	assert.Equal(t, "L2", groups[0].Standings[3].Streak())
+}
+
+func TestCompute_Tiebreakers(t *testing.T) {
+	teams := testTeams[:4]
+	games := []Game{
+		// Patriots and Dolphins split head-to-head games
+		{HomeTeamID: 1, AwayTeamID: 2, HomeScore: 21, AwayScore: 14, KickoffAt: week(1)},
+		{HomeTeamID: 2, AwayTeamID: 1, HomeScore: 21, AwayScore: 14, KickoffAt: week(2)},
+		// Dolphins have a better division record
+		{HomeTeamID: 2, AwayTeamID: 3, HomeScore: 10, AwayScore: 3, KickoffAt: week(3)},
+		{HomeTeamID: 1, AwayTeamID: 5, HomeScore: 10, AwayScore: 3, KickoffAt: week(3)},
+	}
+
+	groups := Compute(append(teams, testTeams[4]), games, GroupDivision)
+
+	assert.Equal(t, 2, groups[0].Standings[0].ID)
+	assert.Equal(t, 1, groups[0].Standings[1].ID)
+
+	// Bills lost to a better opponent, so strength of schedule puts them above Jets
+	assert.Equal(t, 3, groups[0].Standings[2].ID)
+	assert.Equal(t, 4, groups[0].Standings[3].ID)
+}
+
+func TestCompute_WildCardTiebreakers(t *testing.T) {
+	teams := append(append([]Team(nil), testTeams...),
+		Team{ID: 6, Name: "Ravens", Conference: "AFC", Division: "North"},
+		Team{ID: 7, Name: "Chiefs", Conference: "AFC", Division: "West"},
+	)
+	games := []Game{
+		// Patriots beat Ravens and Ravens beat Chiefs, but nobody swept the others
+		{HomeTeamID: 1, AwayTeamID: 6, HomeScore: 21, AwayScore: 14, KickoffAt: week(1)},
+		{HomeTeamID: 6, AwayTeamID: 7, HomeScore: 21, AwayScore: 14, KickoffAt: week(2)},
+		{HomeTeamID: 2, AwayTeamID: 1, HomeScore: 21, AwayScore: 14, KickoffAt: week(2)},
+		{HomeTeamID: 1, AwayTeamID: 5, HomeScore: 21, AwayScore: 14, KickoffAt: week(3)},
+		{HomeTeamID: 6, AwayTeamID: 5, HomeScore: 21, AwayScore: 14, KickoffAt: week(3)},
+		// Chiefs have the best conference record
+		{HomeTeamID: 7, AwayTeamID: 2, HomeScore: 21, AwayScore: 14, KickoffAt: week(3)},
+		{HomeTeamID: 7, AwayTeamID: 3, HomeScore: 21, AwayScore: 14, KickoffAt: week(4)},
+	}
+
+	groups := Compute(teams, games, GroupConference)
+
+	assert.Equal(t, []int{7, 1, 6}, ids(groups[0].Standings[:3]))
+
+	games = []Game{
+		// Patriots win the division over Dolphins, who have the better conference record
+		{HomeTeamID: 1, AwayTeamID: 2, HomeScore: 21, AwayScore: 14, KickoffAt: week(1)},
+		{HomeTeamID: 6, AwayTeamID: 1, HomeScore: 21, AwayScore: 14, KickoffAt: week(2)},
+		{HomeTeamID: 2, AwayTeamID: 6, HomeScore: 21, AwayScore: 14, KickoffAt: week(3)},
+		{HomeTeamID: 2, AwayTeamID: 3, HomeScore: 21, AwayScore: 14, KickoffAt: week(4)},
+		{HomeTeamID: 5, AwayTeamID: 2, HomeScore: 21, AwayScore: 14, KickoffAt: week(5)},
+		{HomeTeamID: 1, AwayTeamID: 5, HomeScore: 21, AwayScore: 14, KickoffAt: week(5)},
+		{HomeTeamID: 5, AwayTeamID: 1, HomeScore: 21, AwayScore: 14, KickoffAt: week(6)},
+		{HomeTeamID: 6, AwayTeamID: 5, HomeScore: 21, AwayScore: 14, KickoffAt: week(6)},
+		{HomeTeamID: 5, AwayTeamID: 6, HomeScore: 21, AwayScore: 14, KickoffAt: week(7)},
+	}
+
+	groups = Compute(teams, games, GroupConference)
+
+	// Ravens beat Patriots once Dolphins are left out, Dolphins follow Patriots
+	assert.Equal(t, []int{6, 1, 2}, ids(groups[0].Standings[:3]))
+}
+
+func ids(standings []Standing) []int {
+	var ids []int
+
+	for _, s := range standings {
+		ids = append(ids, s.ID)
+	}
+
+	return ids
+}
+
+func TestCompute_League(t *testing.T) {
+	groups := Compute(testTeams, testGames, GroupLeague)
+
+	assert.Len(t, groups, 1)
+	assert.Len(t, groups[0].Standings, 5)
+	assert.Equal(t, "", groups[0].Conference)
+}
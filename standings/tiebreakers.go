@@ -0,0 +1,381 @@
+package standings
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Tiebreaker returns a value per club, higher is better, or false if it doesn't apply to the tied clubs
+type tiebreaker func(t *table, tied []int) (map[int]float64, bool)
+
+// Order clubs by win percentage, ties are broken with NFL tiebreaking procedures
+func (t *table) order(ids []int) []int {
+	var ordered []int
+
+	values := map[int]float64{}
+
+	for _, id := range ids {
+		values[id] = t.overall[id].Pct()
+	}
+
+	for _, group := range partition(ids, values) {
+		ordered = append(ordered, t.breakTie(group)...)
+	}
+
+	return ordered
+}
+
+// Apply tiebreakers one by one until tied clubs get separated. Each of the smaller groups that
+// remain tied starts again from the first step, as the NFL procedure requires.
+func (t *table) breakTie(tied []int) []int {
+	if len(tied) < 2 {
+		return tied
+	}
+
+	if !t.sameDivision(tied) && len(t.divisionLeaders(tied)) < len(tied) {
+		return t.breakWildCardTie(tied)
+	}
+
+	for _, step := range t.tiebreakers(tied) {
+		values, ok := step(t, tied)
+
+		if !ok {
+			continue
+		}
+
+		groups := partition(tied, values)
+
+		if len(groups) == 1 {
+			continue
+		}
+
+		var ordered []int
+
+		for _, group := range groups {
+			ordered = append(ordered, t.breakTie(group)...)
+		}
+
+		return ordered
+	}
+
+	// Coin toss is replaced with a stable ordering by id
+	ordered := append([]int(nil), tied...)
+	sort.Ints(ordered)
+
+	return ordered
+}
+
+// Clubs of the same division are reduced to the best of them before wild-card tiebreakers are
+// applied. Once the best club is placed the rest start over, so the next club of its division joins.
+func (t *table) breakWildCardTie(tied []int) []int {
+	var ordered []int
+
+	remaining := tied
+
+	for len(remaining) > 0 {
+		leaders := t.divisionLeaders(remaining)
+
+		if len(leaders) == len(remaining) {
+			return append(ordered, t.breakTie(remaining)...)
+		}
+
+		best := t.breakTie(leaders)[0]
+		ordered = append(ordered, best)
+
+		var rest []int
+
+		for _, id := range remaining {
+			if id != best {
+				rest = append(rest, id)
+			}
+		}
+
+		remaining = rest
+	}
+
+	return ordered
+}
+
+// Best club of every division by division tiebreakers, clubs without division stand alone
+func (t *table) divisionLeaders(ids []int) []int {
+	var keys []string
+
+	divisions := map[string][]int{}
+
+	for _, id := range ids {
+		team := t.teams[id]
+		key := team.Conference + "/" + team.Division
+
+		if team.Division == "" {
+			key = fmt.Sprintf("#%d", id)
+		}
+
+		if _, ok := divisions[key]; !ok {
+			keys = append(keys, key)
+		}
+
+		divisions[key] = append(divisions[key], id)
+	}
+
+	leaders := make([]int, len(keys))
+
+	for i, key := range keys {
+		leaders[i] = t.breakTie(divisions[key])[0]
+	}
+
+	return leaders
+}
+
+func (t *table) tiebreakers(tied []int) []tiebreaker {
+	if t.sameDivision(tied) {
+		return []tiebreaker{headToHead, divisionRecord, commonGames, conferenceRecord, strengthOfVictory, strengthOfSchedule, netPoints}
+	}
+
+	return []tiebreaker{headToHead, conferenceRecord, commonGames, strengthOfVictory, strengthOfSchedule, netPoints}
+}
+
+func (t *table) sameDivision(ids []int) bool {
+	first := t.teams[ids[0]]
+
+	if first.Division == "" {
+		return false
+	}
+
+	for _, id := range ids[1:] {
+		team := t.teams[id]
+
+		if team.Conference != first.Conference || team.Division != first.Division {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t *table) sameConference(ids []int) bool {
+	first := t.teams[ids[0]]
+
+	if first.Conference == "" {
+		return false
+	}
+
+	for _, id := range ids[1:] {
+		if t.teams[id].Conference != first.Conference {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Record in games between the tied clubs, applies only when every club played at least one of them.
+// Among three or more clubs of different divisions only a sweep counts.
+func headToHead(t *table, tied []int) (map[int]float64, bool) {
+	if len(tied) > 2 && !t.sameDivision(tied) {
+		return headToHeadSweep(t, tied)
+	}
+
+	members := set(tied)
+	values := map[int]float64{}
+
+	for _, id := range tied {
+		var record Record
+
+		for _, r := range t.results[id] {
+			if members[r.opponent] {
+				record.add(r.record())
+			}
+		}
+
+		if record == (Record{}) {
+			return nil, false
+		}
+
+		values[id] = record.Pct()
+	}
+
+	return values, true
+}
+
+// Club which defeated each of the others goes first, club which lost to each of them goes last
+func headToHeadSweep(t *table, tied []int) (map[int]float64, bool) {
+	values := map[int]float64{}
+	applies := false
+
+	for _, id := range tied {
+		records := map[int]Record{}
+
+		for _, r := range t.results[id] {
+			record := records[r.opponent]
+			record.add(r.record())
+			records[r.opponent] = record
+		}
+
+		won, lost := true, true
+
+		for _, other := range tied {
+			if other == id {
+				continue
+			}
+
+			record := records[other]
+			won = won && record.Wins > 0 && record.Losses+record.Ties == 0
+			lost = lost && record.Losses > 0 && record.Wins+record.Ties == 0
+		}
+
+		switch {
+		case won:
+			values[id], applies = 1, true
+		case lost:
+			values[id], applies = -1, true
+		}
+	}
+
+	return values, applies
+}
+
+func divisionRecord(t *table, tied []int) (map[int]float64, bool) {
+	values := map[int]float64{}
+
+	for _, id := range tied {
+		values[id] = t.computed[id].DivisionRecord.Pct()
+	}
+
+	return values, true
+}
+
+func conferenceRecord(t *table, tied []int) (map[int]float64, bool) {
+	if !t.sameConference(tied) {
+		return nil, false
+	}
+
+	values := map[int]float64{}
+
+	for _, id := range tied {
+		values[id] = t.computed[id].ConferenceRecord.Pct()
+	}
+
+	return values, true
+}
+
+// Record against opponents all tied clubs have played. Clubs from different divisions need
+// at least minCommonGames of them for the step to apply.
+func commonGames(t *table, tied []int) (map[int]float64, bool) {
+	var common map[int]bool
+
+	for _, id := range tied {
+		opponents := map[int]bool{}
+
+		for _, r := range t.results[id] {
+			if common == nil || common[r.opponent] {
+				opponents[r.opponent] = true
+			}
+		}
+
+		common = opponents
+	}
+
+	if len(common) == 0 {
+		return nil, false
+	}
+
+	minGames := minCommonGames
+
+	if t.sameDivision(tied) {
+		minGames = 1
+	}
+
+	values := map[int]float64{}
+
+	for _, id := range tied {
+		var record Record
+
+		for _, r := range t.results[id] {
+			if common[r.opponent] {
+				record.add(r.record())
+			}
+		}
+
+		if record.Wins+record.Losses+record.Ties < minGames {
+			return nil, false
+		}
+
+		values[id] = record.Pct()
+	}
+
+	return values, true
+}
+
+// Combined winning percentage of the clubs beaten
+func strengthOfVictory(t *table, tied []int) (map[int]float64, bool) {
+	return t.combined(tied, func(r result) bool { return r.pointsFor > r.pointsAgainst }), true
+}
+
+// Combined winning percentage of all opponents
+func strengthOfSchedule(t *table, tied []int) (map[int]float64, bool) {
+	return t.combined(tied, func(r result) bool { return true }), true
+}
+
+func (t *table) combined(tied []int, include func(r result) bool) map[int]float64 {
+	values := map[int]float64{}
+
+	for _, id := range tied {
+		var record Record
+
+		for _, r := range t.results[id] {
+			if include(r) {
+				record.add(t.overall[r.opponent])
+			}
+		}
+
+		values[id] = record.Pct()
+	}
+
+	return values
+}
+
+func netPoints(t *table, tied []int) (map[int]float64, bool) {
+	values := map[int]float64{}
+
+	for _, id := range tied {
+		values[id] = float64(t.computed[id].PointDiff())
+	}
+
+	return values, true
+}
+
+// Split clubs into groups with equal values, best group goes first
+func partition(ids []int, values map[int]float64) [][]int {
+	sorted := append([]int(nil), ids...)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return values[sorted[i]] > values[sorted[j]]
+	})
+
+	var groups [][]int
+
+	for i, id := range sorted {
+		if i == 0 || !equal(values[sorted[i-1]], values[id]) {
+			groups = append(groups, nil)
+		}
+
+		groups[len(groups)-1] = append(groups[len(groups)-1], id)
+	}
+
+	return groups
+}
+
+func equal(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func set(ids []int) map[int]bool {
+	members := map[int]bool{}
+
+	for _, id := range ids {
+		members[id] = true
+	}
+
+	return members
+}
@@ -0,0 +1,127 @@
+package stats
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/logansua/nfl_app/auth"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/utils"
+	"github.com/logansua/nfl_app/validation"
+)
+
+type Endpoints struct {
+	SaveStatLinesEndpoint  endpoint.Endpoint
+	GetPlayerStatsEndpoint endpoint.Endpoint
+	GetLeadersEndpoint     endpoint.Endpoint
+}
+
+func MakeServerEndpoints(s Service, authenticate endpoint.Middleware) Endpoints {
+	validate := validation.Middleware()
+	admin := auth.RequireRole(models.RoleAdmin)
+
+	return Endpoints{
+		SaveStatLinesEndpoint:  authenticate(admin(validate(MakeSaveStatLinesEndpoint(s)))),
+		GetPlayerStatsEndpoint: authenticate(MakeGetPlayerStatsEndpoint(s)),
+		GetLeadersEndpoint:     authenticate(MakeGetLeadersEndpoint(s)),
+	}
+}
+
+func (e Endpoints) SaveStatLines(ctx context.Context, lines []dto.StatLineDTO) error {
+	request := saveStatLinesRequest{Lines: lines}
+	response, err := e.SaveStatLinesEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetPlayerStats(ctx context.Context, playerID, year int, split string) error {
+	request := getPlayerStatsRequest{playerID: playerID, Season: year, Split: split}
+	response, err := e.GetPlayerStatsEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetLeaders(ctx context.Context, year int, stat string, limit int) error {
+	request := getLeadersRequest{year: year, Stat: stat, Limit: limit}
+	response, err := e.GetLeadersEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+
+func MakeSaveStatLinesEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(saveStatLinesRequest)
+
+		lines := req.Lines
+
+		err = service.SaveStatLines(ctx, &lines)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: lines}, nil
+	}
+}
+func MakeGetPlayerStatsEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getPlayerStatsRequest)
+
+		var totals []dto.StatTotalsDTO
+
+		err = service.GetPlayerStats(ctx, req.playerID, req.Season, req.Split, &totals)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: totals}, nil
+	}
+}
+func MakeGetLeadersEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getLeadersRequest)
+
+		var leaders []dto.LeaderDTO
+
+		err = service.GetLeaders(ctx, req.year, req.Stat, req.Limit, &leaders)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: leaders}, nil
+	}
+}
+
+type saveStatLinesRequest struct {
+	Lines []dto.StatLineDTO `json:"lines"`
+}
+
+type getPlayerStatsRequest struct {
+	playerID int
+	Season   int
+	Split    string
+}
+
+type getLeadersRequest struct {
+	year  int
+	Stat  string
+	Limit int
+}
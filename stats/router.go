@@ -0,0 +1,174 @@
+package stats
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/query"
+	"github.com/logansua/nfl_app/router"
+	"github.com/logansua/nfl_app/validation"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultLeaders = 10
+	maxLeaders     = 100
+)
+
+func GetServiceOptions(logger log.Logger) []httptransport.ServerOption {
+	return router.ServerOptions(logger)
+}
+
+func CreateRoutes(s Service, logger log.Logger, authenticate endpoint.Middleware) []router.Route {
+	endpoints := MakeServerEndpoints(s, authenticate)
+
+	options := GetServiceOptions(logger)
+
+	return []router.Route{
+		{
+			Name:        "Save stat lines",
+			Method:      http.MethodPost,
+			Path:        "/stats",
+			StrictSlash: false,
+			Handler: httptransport.NewServer(
+				endpoints.SaveStatLinesEndpoint,
+				decodeSaveStatLinesRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get player stats",
+			Method:      http.MethodGet,
+			Path:        "/players/{id}/stats",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetPlayerStatsEndpoint,
+				decodeGetPlayerStatsRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get season leaders",
+			Method:      http.MethodGet,
+			Path:        "/seasons/{year}/leaders",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetLeadersEndpoint,
+				decodeGetLeadersRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+	}
+}
+
+func decodeSaveStatLinesRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req saveStatLinesRequest
+
+	if e := validation.DecodeJSON(r.Body, &req); e != nil {
+		return nil, e
+	}
+
+	return req, nil
+}
+func decodeGetPlayerStatsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req getPlayerStatsRequest
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	params := r.URL.Query()
+
+	season, err := intParam(params, "season", 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	split := params.Get("split")
+
+	if split == "" {
+		split = SplitSeason
+	}
+
+	if !contains(Splits, split) {
+		return nil, query.InvalidParam("split", split, "must be one of "+strings.Join(Splits, ", "))
+	}
+
+	req.playerID = id
+	req.Season = season
+	req.Split = split
+
+	return req, nil
+}
+func decodeGetLeadersRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req getLeadersRequest
+
+	year, err := strconv.Atoi(mux.Vars(r)["year"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	params := r.URL.Query()
+
+	stat := params.Get("stat")
+
+	if !contains(models.StatColumns, stat) {
+		return nil, query.InvalidParam("stat", stat, "unknown stat")
+	}
+
+	limit, err := intParam(params, "limit", defaultLeaders)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if limit < 1 || limit > maxLeaders {
+		return nil, query.InvalidParam("limit", params.Get("limit"), "must be between 1 and "+strconv.Itoa(maxLeaders))
+	}
+
+	req.year = year
+	req.Stat = stat
+	req.Limit = limit
+
+	return req, nil
+}
+
+// Optional integer query parameter
+func intParam(params url.Values, name string, fallback int) (int, error) {
+	value := params.Get(name)
+
+	if value == "" {
+		return fallback, nil
+	}
+
+	v, err := strconv.Atoi(value)
+
+	if err != nil {
+		return 0, query.InvalidParam(name, value, "must be an integer")
+	}
+
+	return v, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,169 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"github.com/logansua/nfl_app/db"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+)
+
+// Splits of player totals
+const (
+	SplitSeason = "season"
+	SplitWeek   = "week"
+)
+
+var Splits = []string{SplitSeason, SplitWeek}
+
+// Service stores per-game stat lines and aggregates them.
+type Service interface {
+	// Create or replace stat lines of players in games
+	SaveStatLines(ctx context.Context, lines *[]dto.StatLineDTO) error
+	// Get totals of the player split by season or week, all seasons are included when year is zero
+	GetPlayerStats(ctx context.Context, playerID, year int, split string, totals *[]dto.StatTotalsDTO) error
+	// Get players with the highest season total of the stat
+	GetLeaders(ctx context.Context, year int, stat string, limit int, leaders *[]dto.LeaderDTO) error
+}
+
+type service struct {
+	DB *db.DB
+}
+
+func New(dbService *db.DB) Service {
+	return &service{DB: dbService}
+}
+
+func (s *service) SaveStatLines(ctx context.Context, lines *[]dto.StatLineDTO) error {
+	l := make([]models.StatLine, len(*lines))
+
+	for key := range *lines {
+		l[key] = models.NewStatLineModel(&(*lines)[key])
+	}
+
+	err := s.checkReferences(l)
+
+	if err != nil {
+		return err
+	}
+
+	err = s.DB.StatRepository.SaveStatLines(l)
+
+	if err != nil {
+		return err
+	}
+
+	for key, value := range l {
+		(*lines)[key] = models.NewStatLineDTO(value)
+	}
+
+	return nil
+}
+
+// Players and games of all lines must exist and the team of the line has to play in the game.
+// Missing team defaults to the current team of the player.
+func (s *service) checkReferences(lines []models.StatLine) error {
+	var playerIDs, gameIDs []int
+
+	for _, line := range lines {
+		playerIDs = append(playerIDs, line.PlayerID)
+		gameIDs = append(gameIDs, line.GameID)
+	}
+
+	var p []models.Player
+	var g []models.Game
+
+	if err := s.DB.Repository.FindByIds(&p, playerIDs); err != nil {
+		return err
+	}
+
+	if err := s.DB.Repository.FindByIds(&g, gameIDs); err != nil {
+		return err
+	}
+
+	players := map[int]models.Player{}
+	games := map[int]models.Game{}
+
+	for _, value := range p {
+		players[int(value.ID)] = value
+	}
+
+	for _, value := range g {
+		games[int(value.ID)] = value
+	}
+
+	errs := validation.New()
+
+	for key := range lines {
+		line := &lines[key]
+		field := fmt.Sprintf("lines[%d].", key)
+
+		player, playerOk := players[line.PlayerID]
+		game, gameOk := games[line.GameID]
+
+		if !playerOk {
+			errs.Add(field+"player_id", validation.CodeNotFound)
+		}
+
+		if !gameOk {
+			errs.Add(field+"game_id", validation.CodeNotFound)
+		}
+
+		if !playerOk || !gameOk {
+			continue
+		}
+
+		if line.TeamID == 0 && player.TeamID != nil {
+			line.TeamID = *player.TeamID
+		}
+
+		errs.Check(line.TeamID == game.HomeTeamID || line.TeamID == game.AwayTeamID, field+"team_id", validation.CodeInvalid)
+	}
+
+	return errs.Err()
+}
+
+func (s *service) GetPlayerStats(ctx context.Context, playerID, year int, split string, totals *[]dto.StatTotalsDTO) error {
+	var p models.Player
+
+	err := s.DB.Repository.FindById(&p, playerID)
+
+	if err != nil {
+		return err
+	}
+
+	var t []models.StatTotals
+
+	err = s.DB.StatRepository.FindPlayerTotals(playerID, year, split == SplitWeek, &t)
+
+	*totals = make([]dto.StatTotalsDTO, len(t))
+
+	for key, value := range t {
+		(*totals)[key] = models.NewStatTotalsDTO(value)
+	}
+
+	return err
+}
+
+func (s *service) GetLeaders(ctx context.Context, year int, stat string, limit int, leaders *[]dto.LeaderDTO) error {
+	var season models.Season
+
+	err := s.DB.GameRepository.FindSeasonByYear(year, &season)
+
+	if err != nil {
+		return err
+	}
+
+	var l []models.Leader
+
+	err = s.DB.StatRepository.FindLeaders(int(season.ID), stat, limit, &l)
+
+	*leaders = make([]dto.LeaderDTO, len(l))
+
+	for key, value := range l {
+		(*leaders)[key] = models.NewLeaderDTO(value)
+	}
+
+	return err
+}
@@ -0,0 +1,56 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+)
+
+// Maximal number of stat lines in a single request
+const maxLines = 1000
+
+func validateStatLine(errs *validation.Builder, field string, line dto.StatLineDTO) {
+	// Yards may be negative, all other stats are counts
+	counts := []struct {
+		name  string
+		value int
+	}{
+		{"pass_attempts", line.PassAttempts},
+		{"pass_completions", line.PassCompletions},
+		{"passing_touchdowns", line.PassingTouchdowns},
+		{"passing_interceptions", line.PassingInterceptions},
+		{"rush_attempts", line.RushAttempts},
+		{"rushing_touchdowns", line.RushingTouchdowns},
+		{"targets", line.Targets},
+		{"receptions", line.Receptions},
+		{"receiving_touchdowns", line.ReceivingTouchdowns},
+		{"tackles", line.Tackles},
+		{"defensive_interceptions", line.DefensiveInterceptions},
+		{"forced_fumbles", line.ForcedFumbles},
+	}
+
+	errs.
+		ID(field+"player_id", line.PlayerID).
+		ID(field+"game_id", line.GameID).
+		Check(line.TeamID >= 0, field+"team_id", validation.CodeMin).
+		Check(line.Sacks >= 0, field+"sacks", validation.CodeMin)
+
+	for _, count := range counts {
+		errs.Check(count.value >= 0, field+count.name, validation.CodeMin)
+	}
+
+	errs.Check(line.PassCompletions <= line.PassAttempts, field+"pass_completions", validation.CodeMax)
+}
+
+func (r saveStatLinesRequest) Validate(_ context.Context) error {
+	errs := validation.New().
+		Check(len(r.Lines) > 0, "lines", validation.CodeRequired).
+		Check(len(r.Lines) <= maxLines, "lines", validation.CodeMax)
+
+	for key, line := range r.Lines {
+		validateStatLine(errs, fmt.Sprintf("lines[%d].", key), line)
+	}
+
+	return errs.Err()
+}
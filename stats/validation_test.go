@@ -0,0 +1,35 @@
+package stats
+
+import (
+	"context"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSaveStatLinesRequest_Validate(t *testing.T) {
+	valid := dto.StatLineDTO{
+		PlayerID: 1,
+		GameID:   1,
+		StatsDTO: dto.StatsDTO{PassAttempts: 30, PassCompletions: 20, PassingYards: 250, RushingYards: -3},
+	}
+	invalid := dto.StatLineDTO{
+		PlayerID: 1,
+		StatsDTO: dto.StatsDTO{PassAttempts: 10, PassCompletions: 12, Sacks: -1},
+	}
+
+	assert.Nil(t, saveStatLinesRequest{Lines: []dto.StatLineDTO{valid}}.Validate(context.Background()))
+
+	err := saveStatLinesRequest{Lines: []dto.StatLineDTO{valid, invalid}}.Validate(context.Background())
+
+	assert.Equal(t, validation.Errors{
+		{Field: "lines[1].game_id", Code: validation.CodeRequired},
+		{Field: "lines[1].sacks", Code: validation.CodeMin},
+		{Field: "lines[1].pass_completions", Code: validation.CodeMax},
+	}, err)
+
+	err = saveStatLinesRequest{}.Validate(context.Background())
+
+	assert.Equal(t, validation.Errors{{Field: "lines", Code: validation.CodeRequired}}, err)
+}
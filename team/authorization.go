@@ -0,0 +1,83 @@
+package team
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/logansua/nfl_app/auth"
+	"github.com/logansua/nfl_app/bucket"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/utils"
+)
+
+var errDivisionChange = apperrors.New(apperrors.KindForbidden, "only admins may change division of the team")
+
+// Only admins create and delete teams, team managers may change their own teams
+func authorizeAdmin() endpoint.Middleware {
+	return auth.RequireRole(models.RoleAdmin)
+}
+func authorizeTeam() endpoint.Middleware {
+	return auth.ManageTeams(func(_ context.Context, request interface{}) ([]*int, error) {
+		var id int
+
+		switch req := request.(type) {
+		case updateTeamRequest:
+			id = req.id
+		case patchTeamRequest:
+			id = req.id
+		case updateDepthChartRequest:
+			id = req.id
+		case bucket.UploadFileToBucketRequest:
+			id = req.ID
+		default:
+			return nil, apperrors.ErrInternal
+		}
+
+		return []*int{&id}, nil
+	})
+}
+
+// Divisions are part of the league structure, team managers may edit their teams but not move them
+// to another division
+func authorizeDivision(s Service) endpoint.Middleware {
+	return auth.Authorize(func(ctx context.Context, p auth.Principal, request interface{}) error {
+		if p.Role == models.RoleAdmin {
+			return nil
+		}
+
+		var id int
+		var divisionID *int
+
+		switch req := request.(type) {
+		case updateTeamRequest:
+			id, divisionID = req.id, req.Team.DivisionID
+		case patchTeamRequest:
+			ok, err := utils.PatchedField(req.Patch, "division_id", &divisionID)
+
+			if err != nil {
+				return apperrors.Wrap(apperrors.KindInvalidArgument, "invalid division_id", err)
+			}
+
+			if !ok {
+				return nil
+			}
+
+			id = req.id
+		default:
+			return nil
+		}
+
+		var t dto.TeamDTO
+
+		if err := s.GetTeam(ctx, id, &t); err != nil {
+			return err
+		}
+
+		if !models.SameID(t.DivisionID, divisionID) {
+			return errDivisionChange
+		}
+
+		return nil
+	})
+}
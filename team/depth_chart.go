@@ -0,0 +1,94 @@
+package team
+
+import (
+	"context"
+	"fmt"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+)
+
+func (s *service) GetDepthChart(ctx context.Context, id int, chart *dto.DepthChartDTO) error {
+	var t models.Team
+
+	err := s.DB.Repository.FindById(&t, id)
+
+	if err != nil {
+		return err
+	}
+
+	var entries []models.DepthChartEntry
+
+	err = s.DB.DepthChartRepository.FindTeamDepthChart(id, &entries)
+
+	if err != nil {
+		return err
+	}
+
+	*chart = models.NewDepthChartDTO(id, entries)
+
+	return nil
+}
+
+func (s *service) UpdateDepthChart(ctx context.Context, id int, chart *dto.DepthChartDTO) error {
+	var t models.Team
+
+	err := s.DB.Repository.FindById(&t, id)
+
+	if err != nil {
+		return err
+	}
+
+	chart.TeamID = id
+
+	err = s.checkRoster(*chart)
+
+	if err != nil {
+		return err
+	}
+
+	err = s.DB.DepthChartRepository.ReplaceTeamDepthChart(id, models.NewDepthChartModel(chart))
+
+	if err != nil {
+		return err
+	}
+
+	return s.GetDepthChart(ctx, id, chart)
+}
+
+// Every player of the depth chart must be on the roster of the team
+func (s *service) checkRoster(chart dto.DepthChartDTO) error {
+	var ids []int
+
+	for _, slot := range chart.Positions {
+		ids = append(ids, slot.PlayerIDs...)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var players []models.Player
+
+	err := s.DB.Repository.FindByIds(&players, ids)
+
+	if err != nil {
+		return err
+	}
+
+	roster := make(map[int]bool, len(players))
+
+	for _, value := range players {
+		roster[int(value.ID)] = value.TeamID != nil && *value.TeamID == chart.TeamID
+	}
+
+	errs := validation.New()
+
+	for key, slot := range chart.Positions {
+		for index, playerID := range slot.PlayerIDs {
+			errs.Check(roster[playerID], fmt.Sprintf("positions[%d].player_ids[%d]", key, index), validation.CodeNotOnTeam)
+		}
+	}
+
+	return errs.Err()
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/logansua/nfl_app/bucket"
 	"github.com/logansua/nfl_app/models/dto"
 	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
 	"github.com/logansua/nfl_app/utils"
+	"github.com/logansua/nfl_app/validation"
 	"mime/multipart"
 )
 
@@ -14,17 +16,32 @@ type Endpoints struct {
 	CreateTeamEndpoint         endpoint.Endpoint
 	GetTeamsEndpoint           endpoint.Endpoint
 	GetTeamEndpoint            endpoint.Endpoint
+	GetTeamPlayersEndpoint     endpoint.Endpoint
+	UpdateTeamEndpoint         endpoint.Endpoint
+	PatchTeamEndpoint          endpoint.Endpoint
 	DeleteTeamEndpoint         endpoint.Endpoint
 	MakeUploadTeamLogoEndpoint endpoint.Endpoint
+	GetDepthChartEndpoint      endpoint.Endpoint
+	UpdateDepthChartEndpoint   endpoint.Endpoint
 }
 
-func MakeServerEndpoints(s Service) Endpoints {
+func MakeServerEndpoints(s Service, authenticate endpoint.Middleware) Endpoints {
+	validate := validation.Middleware()
+	admin := authorizeAdmin()
+	manager := authorizeTeam()
+	division := authorizeDivision(s)
+
 	return Endpoints{
-		CreateTeamEndpoint:         MakeCreateTeamEndpoint(s),
-		GetTeamsEndpoint:           MakeGetTeamsEndpoint(s),
-		GetTeamEndpoint:            MakeGetTeamEndpoint(s),
-		DeleteTeamEndpoint:         MakeDeleteTeamEndpoint(s),
-		MakeUploadTeamLogoEndpoint: MakeUploadTeamLogoEndpoint(s),
+		CreateTeamEndpoint:         authenticate(admin(validate(MakeCreateTeamEndpoint(s)))),
+		GetTeamsEndpoint:           authenticate(MakeGetTeamsEndpoint(s)),
+		GetTeamEndpoint:            authenticate(MakeGetTeamEndpoint(s)),
+		GetTeamPlayersEndpoint:     authenticate(MakeGetTeamPlayersEndpoint(s)),
+		UpdateTeamEndpoint:         authenticate(manager(division(validate(MakeUpdateTeamEndpoint(s))))),
+		PatchTeamEndpoint:          authenticate(manager(division(MakePatchTeamEndpoint(s)))),
+		DeleteTeamEndpoint:         authenticate(admin(MakeDeleteTeamEndpoint(s))),
+		MakeUploadTeamLogoEndpoint: authenticate(manager(MakeUploadTeamLogoEndpoint(s))),
+		GetDepthChartEndpoint:      authenticate(MakeGetDepthChartEndpoint(s)),
+		UpdateDepthChartEndpoint:   authenticate(manager(validate(MakeUpdateDepthChartEndpoint(s)))),
 	}
 }
 
@@ -40,20 +57,20 @@ func (e Endpoints) CreateTeam(ctx context.Context, p dto.TeamDTO) error {
 
 	return resp.Err
 }
-func (e Endpoints) GetTeams(ctx context.Context, paging pagination.Pagination) error {
-	request := getTeamsRequest{Paging: paging}
+func (e Endpoints) GetTeams(ctx context.Context, paging pagination.Pagination, q query.Query) error {
+	request := getTeamsRequest{Paging: paging, Query: q}
 	response, err := e.GetTeamsEndpoint(ctx, request)
 
 	if err != nil {
 		return err
 	}
 
-	resp := response.(utils.DataResponse)
+	resp := response.(utils.PaginatedResponse)
 
 	return resp.Err
 }
-func (e Endpoints) GetTeam(ctx context.Context, id int) error {
-	request := teamIdRequest{id: id}
+func (e Endpoints) GetTeam(ctx context.Context, id int, include query.Include) error {
+	request := getTeamRequest{id: id, Include: include}
 	response, err := e.GetTeamEndpoint(ctx, request)
 
 	if err != nil {
@@ -64,6 +81,42 @@ func (e Endpoints) GetTeam(ctx context.Context, id int) error {
 
 	return resp.Err
 }
+func (e Endpoints) GetTeamPlayers(ctx context.Context, id int, paging pagination.Pagination, q query.Query) error {
+	request := getTeamPlayersRequest{id: id, Paging: paging, Query: q}
+	response, err := e.GetTeamPlayersEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.PaginatedResponse)
+
+	return resp.Err
+}
+func (e Endpoints) UpdateTeam(ctx context.Context, id int, p dto.TeamDTO) error {
+	request := updateTeamRequest{id: id, Team: p}
+	response, err := e.UpdateTeamEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) PatchTeam(ctx context.Context, id int, patch []byte) error {
+	request := patchTeamRequest{id: id, Patch: patch}
+	response, err := e.PatchTeamEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
 func (e Endpoints) DeleteTeam(ctx context.Context, id int) error {
 	request := teamIdRequest{id: id}
 	response, err := e.DeleteTeamEndpoint(ctx, request)
@@ -88,6 +141,30 @@ func (e Endpoints) UploadTeamAvatar(ctx context.Context, id int, file multipart.
 
 	return resp.Err
 }
+func (e Endpoints) GetDepthChart(ctx context.Context, id int) error {
+	request := teamIdRequest{id: id}
+	response, err := e.GetDepthChartEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) UpdateDepthChart(ctx context.Context, id int, chart dto.DepthChartDTO) error {
+	request := updateDepthChartRequest{id: id, Chart: chart}
+	response, err := e.UpdateDepthChartEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
 
 func MakeCreateTeamEndpoint(service Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
@@ -109,19 +186,32 @@ func MakeGetTeamsEndpoint(service Service) endpoint.Endpoint {
 		req := request.(getTeamsRequest)
 
 		var teams []dto.TeamDTO
+		var meta pagination.Meta
 
-		err = service.GetTeams(ctx, req.Paging, &teams)
+		err = service.GetTeams(ctx, req.Paging, req.Query, &teams, &meta)
 
 		if err != nil {
 			return nil, err
 		}
 
-		return utils.DataResponse{Data: teams}, nil
+		return utils.PaginatedResponse{Data: teams, Meta: meta}, nil
 	}
 }
 func MakeGetTeamEndpoint(service Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
-		req := request.(teamIdRequest)
+		req := request.(getTeamRequest)
+
+		if req.Include.Has("players") {
+			var team dto.TeamRosterDTO
+
+			err = service.GetTeamWithPlayers(ctx, req.id, &team)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return utils.DataResponse{Data: team}, nil
+		}
 
 		var team dto.TeamDTO
 
@@ -134,6 +224,52 @@ func MakeGetTeamEndpoint(service Service) endpoint.Endpoint {
 		return utils.DataResponse{Data: team}, nil
 	}
 }
+func MakeGetTeamPlayersEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getTeamPlayersRequest)
+
+		var players []dto.PlayerDTO
+		var meta pagination.Meta
+
+		err = service.GetTeamPlayers(ctx, req.id, req.Paging, req.Query, &players, &meta)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.PaginatedResponse{Data: players, Meta: meta}, nil
+	}
+}
+func MakeUpdateTeamEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(updateTeamRequest)
+
+		p := req.Team
+
+		err = service.UpdateTeam(ctx, req.id, &p)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: p}, nil
+	}
+}
+func MakePatchTeamEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(patchTeamRequest)
+
+		var team dto.TeamDTO
+
+		err = service.PatchTeam(ctx, req.id, req.Patch, &team)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: team}, nil
+	}
+}
 func MakeDeleteTeamEndpoint(service Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
 		req := request.(teamIdRequest)
@@ -161,15 +297,72 @@ func MakeUploadTeamLogoEndpoint(service Service) endpoint.Endpoint {
 		return utils.DataResponse{Data: team}, nil
 	}
 }
+func MakeGetDepthChartEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(teamIdRequest)
+
+		var chart dto.DepthChartDTO
+
+		err = service.GetDepthChart(ctx, req.id, &chart)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: chart}, nil
+	}
+}
+func MakeUpdateDepthChartEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(updateDepthChartRequest)
+
+		chart := req.Chart
+
+		err = service.UpdateDepthChart(ctx, req.id, &chart)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: chart}, nil
+	}
+}
 
 type createTeamRequest struct {
 	Team dto.TeamDTO
 }
 
+type updateTeamRequest struct {
+	id   int
+	Team dto.TeamDTO
+}
+
+type patchTeamRequest struct {
+	id    int
+	Patch []byte
+}
+
 type getTeamsRequest struct {
 	Paging pagination.Pagination
+	Query  query.Query
+}
+
+type getTeamRequest struct {
+	id      int
+	Include query.Include
+}
+
+type getTeamPlayersRequest struct {
+	id     int
+	Paging pagination.Pagination
+	Query  query.Query
 }
 
 type teamIdRequest struct {
 	id int
 }
+
+type updateDepthChartRequest struct {
+	id    int
+	Chart dto.DepthChartDTO
+}
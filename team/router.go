@@ -2,36 +2,27 @@ package team
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
+	"github.com/go-kit/kit/endpoint"
 	"github.com/go-kit/kit/log"
 	httptransport "github.com/go-kit/kit/transport/http"
 	"github.com/gorilla/mux"
 	"github.com/logansua/nfl_app/bucket"
-	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/db"
 	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
 	"github.com/logansua/nfl_app/router"
+	"github.com/logansua/nfl_app/validation"
+	"io/ioutil"
 	"net/http"
 	"strconv"
 )
 
 func GetServiceOptions(logger log.Logger) []httptransport.ServerOption {
-	return []httptransport.ServerOption{
-		httptransport.ServerErrorLogger(logger),
-		httptransport.ServerErrorEncoder(encodeError),
-		httptransport.ServerAfter(func(ctx context.Context, writer http.ResponseWriter) context.Context {
-			writer.Header().Set("Content-type", "application/json")
-
-			return ctx
-		}),
-		httptransport.ServerFinalizer(func(ctx context.Context, code int, r *http.Request) {
-			logger.Log("METHOD", r.Method, "PATH", r.URL.Path, "CODE", code)
-		}),
-	}
+	return router.ServerOptions(logger)
 }
 
-func CreateRoutes(s Service, logger log.Logger) []router.Route {
-	endpoints := MakeServerEndpoints(s)
+func CreateRoutes(s Service, logger log.Logger, authenticate endpoint.Middleware) []router.Route {
+	endpoints := MakeServerEndpoints(s, authenticate)
 
 	options := GetServiceOptions(logger)
 
@@ -44,7 +35,7 @@ func CreateRoutes(s Service, logger log.Logger) []router.Route {
 			Handler: httptransport.NewServer(
 				endpoints.CreateTeamEndpoint,
 				decodeCreateTeamRequest,
-				encodeResponse,
+				router.EncodeResponse,
 				options...,
 			),
 		},
@@ -56,7 +47,7 @@ func CreateRoutes(s Service, logger log.Logger) []router.Route {
 			Handler: httptransport.NewServer(
 				endpoints.GetTeamsEndpoint,
 				decodeGetTeamsRequest,
-				encodeResponse,
+				router.EncodePaginatedResponse,
 				options...,
 			),
 		},
@@ -68,7 +59,43 @@ func CreateRoutes(s Service, logger log.Logger) []router.Route {
 			Handler: httptransport.NewServer(
 				endpoints.GetTeamEndpoint,
 				decodeGetTeamRequest,
-				encodeResponse,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get team players",
+			Method:      http.MethodGet,
+			Path:        "/teams/{id}/players",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetTeamPlayersEndpoint,
+				decodeGetTeamPlayersRequest,
+				router.EncodePaginatedResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Update team",
+			Method:      http.MethodPut,
+			Path:        "/teams/{id}",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.UpdateTeamEndpoint,
+				decodeUpdateTeamRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Patch team",
+			Method:      http.MethodPatch,
+			Path:        "/teams/{id}",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.PatchTeamEndpoint,
+				decodePatchTeamRequest,
+				router.EncodeResponse,
 				options...,
 			),
 		},
@@ -80,7 +107,7 @@ func CreateRoutes(s Service, logger log.Logger) []router.Route {
 			Handler: httptransport.NewServer(
 				endpoints.DeleteTeamEndpoint,
 				decodeDeleteTeamRequest,
-				encodeDeleteTeamResponse,
+				router.EncodeNoContentResponse,
 				options...,
 			),
 		},
@@ -92,7 +119,31 @@ func CreateRoutes(s Service, logger log.Logger) []router.Route {
 			Handler: httptransport.NewServer(
 				endpoints.MakeUploadTeamLogoEndpoint,
 				decodeUploadTeamAvatarRequest,
-				encodeResponse,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get team depth chart",
+			Method:      http.MethodGet,
+			Path:        "/teams/{id}/depth-chart",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetDepthChartEndpoint,
+				decodeGetDepthChartRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Update team depth chart",
+			Method:      http.MethodPut,
+			Path:        "/teams/{id}/depth-chart",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.UpdateDepthChartEndpoint,
+				decodeUpdateDepthChartRequest,
+				router.EncodeResponse,
 				options...,
 			),
 		},
@@ -102,7 +153,7 @@ func CreateRoutes(s Service, logger log.Logger) []router.Route {
 func decodeCreateTeamRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
 	var req createTeamRequest
 
-	if e := json.NewDecoder(r.Body).Decode(&req.Team); e != nil {
+	if e := validation.DecodeJSON(r.Body, &req.Team); e != nil {
 		return nil, e
 	}
 
@@ -113,14 +164,35 @@ func decodeGetTeamsRequest(_ context.Context, r *http.Request) (request interfac
 
 	params := r.URL.Query()
 
-	paging := pagination.New(params)
+	paging, err := pagination.Parse(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := query.New(params, db.TeamQueryFields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	include, err := query.NewInclude(params, "division")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if include.Has("division") {
+		q.Preload = append(q.Preload, "Division.Conference")
+	}
 
 	req.Paging = paging
+	req.Query = q
 
 	return req, nil
 }
 func decodeGetTeamRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
-	var req teamIdRequest
+	var req getTeamRequest
 
 	params := mux.Vars(r)
 
@@ -130,7 +202,84 @@ func decodeGetTeamRequest(_ context.Context, r *http.Request) (request interface
 		return nil, err
 	}
 
+	include, err := query.NewInclude(r.URL.Query(), "players")
+
+	if err != nil {
+		return nil, err
+	}
+
 	req.id = id
+	req.Include = include
+
+	return req, nil
+}
+func decodeGetTeamPlayersRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req getTeamPlayersRequest
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	params := r.URL.Query()
+
+	paging, err := pagination.Parse(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := query.New(params, db.PlayerQueryFields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.id = id
+	req.Paging = paging
+	req.Query = q
+
+	return req, nil
+}
+func decodeUpdateTeamRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req updateTeamRequest
+
+	params := mux.Vars(r)
+
+	id, err := strconv.Atoi(params["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	if e := validation.DecodeJSON(r.Body, &req.Team); e != nil {
+		return nil, e
+	}
+
+	req.id = id
+
+	return req, nil
+}
+func decodePatchTeamRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req patchTeamRequest
+
+	params := mux.Vars(r)
+
+	id, err := strconv.Atoi(params["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := ioutil.ReadAll(r.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.id = id
+	req.Patch = patch
 
 	return req, nil
 }
@@ -151,18 +300,16 @@ func decodeDeleteTeamRequest(_ context.Context, r *http.Request) (request interf
 }
 func decodeUploadTeamAvatarRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
 	const (
-		maxUploadSize = 2 * 1024 * 1024 // 2 mb
+		maxUploadSize = 2 * 1024 * 1024 // 2 mb, whole body including form fields
 	)
 
-	//r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
-
-	if err := r.ParseMultipartForm(maxUploadSize); err != nil {
-		return nil, errors.New("file is too big")
+	if err := router.ParseMultipartForm(r, maxUploadSize); err != nil {
+		return nil, err
 	}
 
 	file, fileHeader, err := r.FormFile("image")
 	if err == http.ErrMissingFile {
-		return
+		return nil, validation.Errors{{Field: "image", Code: validation.CodeRequired}}
 	}
 	if err != nil {
 		return nil, err
@@ -184,56 +331,37 @@ func decodeUploadTeamAvatarRequest(_ context.Context, r *http.Request) (request
 
 	return req, nil
 }
+func decodeGetDepthChartRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req teamIdRequest
 
-type errorer interface {
-	error() error
-}
+	params := mux.Vars(r)
 
-func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
-	if e, ok := response.(errorer); ok && e.error() != nil {
-		// Not a Go kit transport error, but a business-logic error.
-		// Provide those as HTTP errors.
-		encodeError(ctx, e.error(), w)
+	id, err := strconv.Atoi(params["id"])
 
-		return nil
+	if err != nil {
+		return nil, err
 	}
 
-	return json.NewEncoder(w).Encode(response)
-}
-
-func encodeDeleteTeamResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
-	if e, ok := response.(errorer); ok && e.error() != nil {
-		// Not a Go kit transport error, but a business-logic error.
-		// Provide those as HTTP errors.
-		encodeError(ctx, e.error(), w)
+	req.id = id
 
-		return nil
-	}
+	return req, nil
+}
+func decodeUpdateDepthChartRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req updateDepthChartRequest
 
-	w.WriteHeader(http.StatusNoContent)
+	params := mux.Vars(r)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	id, err := strconv.Atoi(params["id"])
 
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
-	if err == nil {
-		panic("encodeError with nil error")
+	if err != nil {
+		return nil, err
 	}
 
-	w.WriteHeader(codeFrom(err))
+	if e := validation.DecodeJSON(r.Body, &req.Chart); e != nil {
+		return nil, e
+	}
 
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"error": err.Error(),
-	})
-}
+	req.id = id
 
-func codeFrom(err error) int {
-	switch err {
-	case apperrors.ErrNotFound:
-		return http.StatusNotFound
-	case apperrors.ErrAlreadyExists, apperrors.ErrInconsistentIDs:
-		return http.StatusBadRequest
-	default:
-		return http.StatusInternalServerError
-	}
+	return req, nil
 }
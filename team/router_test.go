@@ -0,0 +1,149 @@
+package team
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/logansua/nfl_app/auth"
+	"github.com/logansua/nfl_app/db"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/mocks"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/router"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+// Handler of team routes called by the given principal
+func newTestHandler(dbService *db.DB, p auth.Principal) http.Handler {
+	authenticate := func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			return next(auth.NewContext(ctx, p), request)
+		}
+	}
+
+	return router.New(CreateRoutes(New(dbService, nil), log.NewNopLogger(), authenticate))
+}
+
+func serve(handler http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, httptest.NewRequest(method, path, strings.NewReader(body)))
+
+	return w
+}
+
+func TestGetTeamPlayers_UnknownTeam(t *testing.T) {
+	repository := &mocks.Repository{}
+	repository.On("FindById", mock.AnythingOfType("*models.Team"), 99).Return(apperrors.ErrNotFound)
+
+	handler := newTestHandler(&db.DB{Repository: repository}, auth.Principal{Role: models.RoleViewer})
+
+	w := serve(handler, http.MethodGet, "/teams/99/players", "")
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	repository.AssertExpectations(t)
+}
+
+func TestGetTeam_IncludePlayers(t *testing.T) {
+	team := models.Team{
+		ID:        1,
+		Name:      "TEST_TEAM",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		Players: []models.Player{
+			{ID: 1, Name: "TEST_PLAYER_1", TeamID: intPtr(1)},
+			{ID: 2, Name: "TEST_PLAYER_2", TeamID: intPtr(1)},
+		},
+	}
+
+	teamRepository := &mocks.TeamRepository{}
+	teamRepository.On("FindWithPlayers", 1, mock.AnythingOfType("*models.Team")).
+		Run(func(args mock.Arguments) {
+			*args.Get(1).(*models.Team) = team
+		}).
+		Return(nil)
+
+	handler := newTestHandler(&db.DB{TeamRepository: teamRepository}, auth.Principal{Role: models.RoleViewer})
+
+	w := serve(handler, http.MethodGet, "/teams/1?include=players", "")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var body struct {
+		Data struct {
+			ID      uint `json:"id"`
+			Players []struct {
+				ID     uint `json:"id"`
+				TeamID *int `json:"team_id"`
+			} `json:"players"`
+		} `json:"data"`
+	}
+
+	assert.Nil(t, json.NewDecoder(w.Body).Decode(&body))
+	assert.Equal(t, uint(1), body.Data.ID)
+	assert.Len(t, body.Data.Players, 2)
+	assert.Equal(t, uint(2), body.Data.Players[1].ID)
+	assert.Equal(t, intPtr(1), body.Data.Players[1].TeamID)
+
+	teamRepository.AssertExpectations(t)
+}
+
+func TestUpdateTeam_Division(t *testing.T) {
+	repository := &mocks.Repository{}
+	repository.On("FindById", mock.AnythingOfType("*models.Team"), 1).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*models.Team) = models.Team{ID: 1, Name: "TEST_TEAM"}
+		}).
+		Return(nil)
+	repository.On("Save", mock.AnythingOfType("*models.Team")).Return(nil)
+
+	manager := auth.Principal{Role: models.RoleTeamManager, TeamIDs: []int{1}}
+	handler := newTestHandler(&db.DB{Repository: repository}, manager)
+
+	w := serve(handler, http.MethodPut, "/teams/1", `{"name":"TEST_TEAM","division_id":2}`)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = serve(handler, http.MethodPatch, "/teams/1", `{"division_id":2}`)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = serve(handler, http.MethodPatch, "/teams/1", `{"name":"RENAMED"}`)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	repository.AssertNumberOfCalls(t, "Save", 1)
+}
+
+func TestUploadTeamAvatar_MissingImage(t *testing.T) {
+	handler := newTestHandler(&db.DB{}, auth.Principal{Role: models.RoleAdmin})
+
+	var body strings.Builder
+
+	form := multipart.NewWriter(&body)
+	assert.Nil(t, form.WriteField("name", "logo"))
+	assert.Nil(t, form.Close())
+
+	r := httptest.NewRequest(http.MethodPut, "/teams/1/logo", strings.NewReader(body.String()))
+	r.Header.Set("Content-Type", form.FormDataContentType())
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	assert.Equal(t, apperrors.KindValidation.Status(), w.Code)
+	assert.Contains(t, w.Body.String(), `"image"`)
+}
@@ -1,13 +1,19 @@
 package team
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"github.com/logansua/nfl_app/bucket"
 	"github.com/logansua/nfl_app/db"
+	apperrors "github.com/logansua/nfl_app/errors"
 	"github.com/logansua/nfl_app/models"
 	"github.com/logansua/nfl_app/models/dto"
 	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
+	"github.com/logansua/nfl_app/utils"
+	"github.com/logansua/nfl_app/validation"
 	"mime/multipart"
 )
 
@@ -16,13 +22,25 @@ type Service interface {
 	// Create player
 	CreateTeam(ctx context.Context, team *dto.TeamDTO) error
 	// Get list of players
-	GetTeams(ctx context.Context, paging pagination.Pagination, players *[]dto.TeamDTO) error
+	GetTeams(ctx context.Context, paging pagination.Pagination, q query.Query, teams *[]dto.TeamDTO, meta *pagination.Meta) error
 	// Get single player by ID
 	GetTeam(ctx context.Context, id int, player *dto.TeamDTO) error
+	// Get single team by ID with preloaded roster
+	GetTeamWithPlayers(ctx context.Context, id int, team *dto.TeamRosterDTO) error
+	// Get players of the team
+	GetTeamPlayers(ctx context.Context, id int, paging pagination.Pagination, q query.Query, players *[]dto.PlayerDTO, meta *pagination.Meta) error
+	// Update team by ID
+	UpdateTeam(ctx context.Context, id int, team *dto.TeamDTO) error
+	// Partially update team by ID with JSON merge patch
+	PatchTeam(ctx context.Context, id int, patch []byte, team *dto.TeamDTO) error
 	// Delete player by ID
 	DeleteTeam(ctx context.Context, id int) error
 	// Upload player avatar by ID
 	UploadTeamLogo(ctx context.Context, id int, file multipart.File, fileHeader *multipart.FileHeader, p *dto.TeamDTO) error
+	// Get starters and backups of the team by position
+	GetDepthChart(ctx context.Context, id int, chart *dto.DepthChartDTO) error
+	// Replace depth chart of the team, only players on the roster can be listed
+	UpdateDepthChart(ctx context.Context, id int, chart *dto.DepthChartDTO) error
 }
 
 type service struct {
@@ -37,19 +55,42 @@ func New(dbService *db.DB, bucketService bucket.Service) Service {
 func (s *service) CreateTeam(ctx context.Context, team *dto.TeamDTO) error {
 	t := models.NewTeamModel(team)
 
-	err := s.DB.Repository.Create(&t)
+	err := s.checkDivision(t.DivisionID)
+
+	if err != nil {
+		return err
+	}
+
+	err = s.DB.Repository.Create(&t)
 
 	*team = models.NewTeamDTO(t)
 
 	return err
 }
 
-func (s *service) GetTeams(ctx context.Context, paging pagination.Pagination, teams *[]dto.TeamDTO) error {
+// Referential check of team division, teams may stay out of divisions
+func (s *service) checkDivision(divisionID *int) error {
+	if divisionID == nil {
+		return nil
+	}
+
+	var d models.Division
+
+	err := s.DB.LeagueRepository.FindDivision(*divisionID, &d)
+
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return validation.Errors{{Field: "division_id", Code: validation.CodeNotFound}}
+	}
+
+	return err
+}
+
+func (s *service) GetTeams(ctx context.Context, paging pagination.Pagination, q query.Query, teams *[]dto.TeamDTO, meta *pagination.Meta) error {
 	var t []models.Team
 
 	err := s.DB.
 		TeamRepository.
-		FindAllAndPaginate(paging, &t)
+		FindAllAndPaginate(paging, q, &t, meta)
 
 	*teams = make([]dto.TeamDTO, len(t))
 
@@ -70,6 +111,118 @@ func (s *service) GetTeam(ctx context.Context, id int, team *dto.TeamDTO) error
 	return err
 }
 
+func (s *service) GetTeamWithPlayers(ctx context.Context, id int, team *dto.TeamRosterDTO) error {
+	var t models.Team
+
+	err := s.DB.TeamRepository.FindWithPlayers(id, &t)
+
+	if err != nil {
+		return err
+	}
+
+	*team = models.NewTeamRosterDTO(t)
+
+	return nil
+}
+
+func (s *service) GetTeamPlayers(ctx context.Context, id int, paging pagination.Pagination, q query.Query, players *[]dto.PlayerDTO, meta *pagination.Meta) error {
+	var t models.Team
+
+	err := s.DB.Repository.FindById(&t, id)
+
+	if err != nil {
+		return err
+	}
+
+	q.Filters = append(q.Filters, query.Filter{Column: "team_id", Operator: query.Equal, Value: t.ID})
+
+	var p []models.Player
+
+	err = s.DB.
+		PlayerRepository.
+		FindAllAndPaginate(paging, q, &p, meta)
+
+	*players = make([]dto.PlayerDTO, len(p))
+
+	for key, value := range p {
+		(*players)[key] = models.NewPlayerDTO(value)
+	}
+
+	return err
+}
+
+func (s *service) UpdateTeam(ctx context.Context, id int, team *dto.TeamDTO) error {
+	var t models.Team
+
+	err := s.DB.Repository.FindById(&t, id)
+
+	if err != nil {
+		return err
+	}
+
+	err = s.checkDivision(team.DivisionID)
+
+	if err != nil {
+		return err
+	}
+
+	t.Name = team.Name
+	t.Logo = team.Logo
+	t.DivisionID = team.DivisionID
+
+	err = s.DB.Repository.Save(&t)
+
+	*team = models.NewTeamDTO(t)
+
+	return err
+}
+
+func (s *service) PatchTeam(ctx context.Context, id int, patch []byte, team *dto.TeamDTO) error {
+	var t models.Team
+
+	err := s.DB.Repository.FindById(&t, id)
+
+	if err != nil {
+		return err
+	}
+
+	original, err := json.Marshal(models.NewTeamDTO(t))
+
+	if err != nil {
+		return err
+	}
+
+	patched, err := utils.MergePatch(original, patch)
+
+	if err != nil {
+		return err
+	}
+
+	var changes dto.TeamDTO
+
+	if err := validation.DecodeJSON(bytes.NewReader(patched), &changes); err != nil {
+		return err
+	}
+
+	if err := validateTeam(changes); err != nil {
+		return err
+	}
+
+	if err := s.checkDivision(changes.DivisionID); err != nil {
+		return err
+	}
+
+	t.Name = changes.Name
+	t.Logo = changes.Logo
+	t.DivisionID = changes.DivisionID
+
+	err = s.DB.Repository.Save(&t)
+
+	*team = models.NewTeamDTO(t)
+
+	return err
+}
+
 func (s *service) DeleteTeam(ctx context.Context, id int) error {
 	var t models.Team
 
@@ -88,16 +241,16 @@ func (s *service) UploadTeamLogo(ctx context.Context, id int, file multipart.Fil
 	var t models.Team
 
 	if err := s.DB.Repository.FindById(&t, id); err != nil {
-		return errors.New("player not found")
+		return err
 	}
 
-	name, err := s.BucketService.UploadTeamLogo(ctx, t.ID, file, fileHeader)
+	url, err := s.BucketService.UploadTeamLogo(ctx, t.ID, file, fileHeader)
 
 	if err != nil {
 		return err
 	}
 
-	t.Logo = name
+	t.Logo = url
 
 	err = s.DB.Repository.Save(&t)
 
@@ -0,0 +1,71 @@
+package team
+
+import (
+	"context"
+	"fmt"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+)
+
+const (
+	maxLength = 255
+	maxDepth  = 15
+)
+
+func validateTeam(team dto.TeamDTO) error {
+	errs := validation.New().
+		Required("name", team.Name).
+		MaxLength("name", team.Name, maxLength).
+		MaxLength("logo", team.Logo, maxLength)
+
+	if team.DivisionID != nil {
+		errs.ID("division_id", *team.DivisionID)
+	}
+
+	return errs.Err()
+}
+
+func (r createTeamRequest) Validate(_ context.Context) error {
+	return validateTeam(r.Team)
+}
+
+func (r updateTeamRequest) Validate(_ context.Context) error {
+	return validateTeam(r.Team)
+}
+
+func validateDepthChart(chart dto.DepthChartDTO) error {
+	errs := validation.New()
+
+	positions := make(map[string]bool, len(chart.Positions))
+
+	for key, slot := range chart.Positions {
+		field := fmt.Sprintf("positions[%d].", key)
+
+		errs.
+			OneOf(field+"position", slot.Position, models.Positions...).
+			Check(!positions[slot.Position], field+"position", validation.CodeDuplicate).
+			Check(len(slot.PlayerIDs) <= maxDepth, field+"player_ids", validation.CodeMax)
+
+		positions[slot.Position] = true
+
+		// Player may play several positions but is listed only once at each of them
+		players := make(map[int]bool, len(slot.PlayerIDs))
+
+		for index, playerID := range slot.PlayerIDs {
+			playerField := fmt.Sprintf("%splayer_ids[%d]", field, index)
+
+			errs.
+				ID(playerField, playerID).
+				Check(!players[playerID], playerField, validation.CodeDuplicate)
+
+			players[playerID] = true
+		}
+	}
+
+	return errs.Err()
+}
+
+func (r updateDepthChartRequest) Validate(_ context.Context) error {
+	return validateDepthChart(r.Chart)
+}
@@ -0,0 +1,54 @@
+package transaction
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/logansua/nfl_app/auth"
+	"github.com/logansua/nfl_app/models/dto"
+)
+
+// Team managers may move players only between their teams, signing a free agent affects only the
+// team joined and releasing a player only the team left
+func authorizeTransaction(s Service) endpoint.Middleware {
+	return auth.ManageTeams(func(ctx context.Context, request interface{}) ([]*int, error) {
+		req := request.(createTransactionRequest)
+
+		var p dto.PlayerDTO
+
+		if err := s.GetPlayer(ctx, req.Transaction.PlayerID, &p); err != nil {
+			return nil, err
+		}
+
+		var teamIDs []*int
+
+		for _, teamID := range []*int{p.TeamID, req.Transaction.ToTeamID} {
+			if teamID != nil {
+				teamIDs = append(teamIDs, teamID)
+			}
+		}
+
+		return teamIDs, nil
+	})
+}
+
+// Every team trading players or picks must be managed by the caller, teams of the items are checked
+// against the roster by the service
+func authorizeTrade() endpoint.Middleware {
+	return auth.ManageTeams(func(_ context.Context, request interface{}) ([]*int, error) {
+		req := request.(createTradeRequest)
+
+		var teamIDs []*int
+
+		for key := range req.Trade.Items {
+			item := &req.Trade.Items[key]
+			teamIDs = append(teamIDs, &item.FromTeamID, &item.ToTeamID)
+		}
+
+		for key := range req.Trade.Picks {
+			pick := &req.Trade.Picks[key]
+			teamIDs = append(teamIDs, &pick.FromTeamID, &pick.ToTeamID)
+		}
+
+		return teamIDs, nil
+	})
+}
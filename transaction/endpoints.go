@@ -0,0 +1,194 @@
+package transaction
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
+	"github.com/logansua/nfl_app/utils"
+	"github.com/logansua/nfl_app/validation"
+)
+
+type Endpoints struct {
+	CreateTransactionEndpoint   endpoint.Endpoint
+	GetPlayerHistoryEndpoint    endpoint.Endpoint
+	GetTeamTransactionsEndpoint endpoint.Endpoint
+	CreateTradeEndpoint         endpoint.Endpoint
+	GetTradeEndpoint            endpoint.Endpoint
+}
+
+func MakeServerEndpoints(s Service, authenticate endpoint.Middleware) Endpoints {
+	validate := validation.Middleware()
+
+	return Endpoints{
+		CreateTransactionEndpoint:   authenticate(authorizeTransaction(s)(validate(MakeCreateTransactionEndpoint(s)))),
+		GetPlayerHistoryEndpoint:    authenticate(MakeGetPlayerHistoryEndpoint(s)),
+		GetTeamTransactionsEndpoint: authenticate(MakeGetTeamTransactionsEndpoint(s)),
+		CreateTradeEndpoint:         authenticate(authorizeTrade()(validate(MakeCreateTradeEndpoint(s)))),
+		GetTradeEndpoint:            authenticate(MakeGetTradeEndpoint(s)),
+	}
+}
+
+func (e Endpoints) CreateTransaction(ctx context.Context, t dto.TransactionDTO) error {
+	request := createTransactionRequest{Transaction: t}
+	response, err := e.CreateTransactionEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetPlayerHistory(ctx context.Context, playerID int, paging pagination.Pagination, q query.Query) error {
+	request := getPlayerHistoryRequest{playerID: playerID, Paging: paging, Query: q}
+	response, err := e.GetPlayerHistoryEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.PaginatedResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetTeamTransactions(ctx context.Context, teamID int, paging pagination.Pagination, q query.Query) error {
+	request := getTeamTransactionsRequest{teamID: teamID, Paging: paging, Query: q}
+	response, err := e.GetTeamTransactionsEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.PaginatedResponse)
+
+	return resp.Err
+}
+func (e Endpoints) CreateTrade(ctx context.Context, t dto.TradeDTO) error {
+	request := createTradeRequest{Trade: t}
+	response, err := e.CreateTradeEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+func (e Endpoints) GetTrade(ctx context.Context, id int) error {
+	request := getTradeRequest{id: id}
+	response, err := e.GetTradeEndpoint(ctx, request)
+
+	if err != nil {
+		return err
+	}
+
+	resp := response.(utils.DataResponse)
+
+	return resp.Err
+}
+
+func MakeCreateTransactionEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(createTransactionRequest)
+
+		t := req.Transaction
+
+		err = service.CreateTransaction(ctx, &t)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: t}, nil
+	}
+}
+func MakeGetPlayerHistoryEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getPlayerHistoryRequest)
+
+		var transactions []dto.TransactionDTO
+		var meta pagination.Meta
+
+		err = service.GetPlayerHistory(ctx, req.playerID, req.Paging, req.Query, &transactions, &meta)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.PaginatedResponse{Data: transactions, Meta: meta}, nil
+	}
+}
+func MakeGetTeamTransactionsEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getTeamTransactionsRequest)
+
+		var transactions []dto.TransactionDTO
+		var meta pagination.Meta
+
+		err = service.GetTeamTransactions(ctx, req.teamID, req.Paging, req.Query, &transactions, &meta)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.PaginatedResponse{Data: transactions, Meta: meta}, nil
+	}
+}
+func MakeCreateTradeEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(createTradeRequest)
+
+		t := req.Trade
+
+		err = service.CreateTrade(ctx, &t)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: t}, nil
+	}
+}
+func MakeGetTradeEndpoint(service Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getTradeRequest)
+
+		var t dto.TradeDTO
+
+		err = service.GetTrade(ctx, req.id, &t)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.DataResponse{Data: t}, nil
+	}
+}
+
+type createTransactionRequest struct {
+	Transaction dto.TransactionDTO
+}
+
+type getPlayerHistoryRequest struct {
+	playerID int
+	Paging   pagination.Pagination
+	Query    query.Query
+}
+
+type getTeamTransactionsRequest struct {
+	teamID int
+	Paging pagination.Pagination
+	Query  query.Query
+}
+
+type createTradeRequest struct {
+	Trade dto.TradeDTO
+}
+
+type getTradeRequest struct {
+	id int
+}
@@ -0,0 +1,70 @@
+package transaction
+
+import (
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/validation"
+)
+
+// Codes of transactions which don't match the current roster
+const (
+	// Player has no team to leave
+	CodeFreeAgent = "free_agent"
+	// Player is already under contract with a team
+	CodeRostered = "rostered"
+	// Player would be traded to the current team
+	CodeSameTeam = "same_team"
+	// Draft pick isn't owned by the team trading it
+	CodeNotOwned = "not_owned"
+	// Player was already selected with the draft pick
+	CodePickUsed = "used"
+)
+
+// Fill teams and status of the transaction from the current state of the player
+func plan(t *models.Transaction, player models.Player) error {
+	errs := validation.New()
+
+	t.FromTeamID = player.TeamID
+
+	switch t.Type {
+	case models.TransactionSign:
+		errs.Check(player.TeamID == nil, "player_id", CodeRostered)
+
+		if t.Status == "" {
+			t.Status = models.PlayerActive
+		}
+
+		errs.OneOf("status", t.Status, models.PlayerActive, models.PlayerPracticeSquad)
+	case models.TransactionTrade:
+		errs.
+			Check(player.TeamID != nil, "player_id", CodeFreeAgent).
+			Check(player.TeamID == nil || t.ToTeamID == nil || *player.TeamID != *t.ToTeamID, "to_team_id", CodeSameTeam)
+
+		t.Status = player.Status
+	case models.TransactionRelease, models.TransactionWaive:
+		errs.Check(player.TeamID != nil, "player_id", CodeFreeAgent)
+
+		t.ToTeamID = nil
+		t.Status = models.PlayerActive
+	case models.TransactionInjuredReserve:
+		errs.
+			Check(player.TeamID != nil, "player_id", CodeFreeAgent).
+			Check(player.Status != models.PlayerInjuredReserve, "status", validation.CodeInvalid)
+
+		t.ToTeamID = player.TeamID
+		t.Status = models.PlayerInjuredReserve
+	case models.TransactionActivate, models.TransactionSuspend:
+		t.Status = models.PlayerActive
+
+		if t.Type == models.TransactionSuspend {
+			t.Status = models.PlayerSuspended
+		}
+
+		errs.
+			Check(player.TeamID != nil, "player_id", CodeFreeAgent).
+			Check(player.Status != t.Status, "status", validation.CodeInvalid)
+
+		t.ToTeamID = player.TeamID
+	}
+
+	return errs.Err()
+}
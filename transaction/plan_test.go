@@ -0,0 +1,123 @@
+package transaction
+
+import (
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestPlan(t *testing.T) {
+	freeAgent := models.Player{ID: 1, Status: models.PlayerActive}
+	rostered := models.Player{ID: 2, TeamID: intPtr(1), Status: models.PlayerActive}
+
+	sign := models.Transaction{Type: models.TransactionSign, PlayerID: 1, ToTeamID: intPtr(2)}
+
+	assert.Nil(t, plan(&sign, freeAgent))
+	assert.Nil(t, sign.FromTeamID)
+	assert.Equal(t, models.PlayerActive, sign.Status)
+
+	trade := models.Transaction{Type: models.TransactionTrade, PlayerID: 2, ToTeamID: intPtr(3)}
+
+	assert.Nil(t, plan(&trade, rostered))
+	assert.Equal(t, intPtr(1), trade.FromTeamID)
+
+	release := models.Transaction{Type: models.TransactionRelease, PlayerID: 2}
+
+	assert.Nil(t, plan(&release, rostered))
+	assert.Nil(t, release.ToTeamID)
+
+	injury := models.Transaction{Type: models.TransactionInjuredReserve, PlayerID: 2}
+
+	assert.Nil(t, plan(&injury, rostered))
+	assert.Equal(t, intPtr(1), injury.ToTeamID)
+	assert.Equal(t, models.PlayerInjuredReserve, injury.Status)
+
+	suspension := models.Transaction{Type: models.TransactionSuspend, PlayerID: 2}
+
+	assert.Nil(t, plan(&suspension, rostered))
+	assert.Equal(t, intPtr(1), suspension.ToTeamID)
+	assert.Equal(t, models.PlayerSuspended, suspension.Status)
+
+	activation := models.Transaction{Type: models.TransactionActivate, PlayerID: 2}
+
+	assert.Nil(t, plan(&activation, models.Player{ID: 2, TeamID: intPtr(1), Status: models.PlayerInjuredReserve}))
+	assert.Equal(t, intPtr(1), activation.ToTeamID)
+	assert.Equal(t, models.PlayerActive, activation.Status)
+}
+
+func TestPlan_RosterMismatch(t *testing.T) {
+	freeAgent := models.Player{ID: 1, Status: models.PlayerActive}
+	rostered := models.Player{ID: 2, TeamID: intPtr(1), Status: models.PlayerActive}
+
+	cases := []struct {
+		transaction models.Transaction
+		player      models.Player
+		expected    validation.Errors
+	}{
+		{
+			models.Transaction{Type: models.TransactionSign, ToTeamID: intPtr(2)},
+			rostered,
+			validation.Errors{{Field: "player_id", Code: CodeRostered}},
+		},
+		{
+			models.Transaction{Type: models.TransactionTrade, ToTeamID: intPtr(1)},
+			rostered,
+			validation.Errors{{Field: "to_team_id", Code: CodeSameTeam}},
+		},
+		{
+			models.Transaction{Type: models.TransactionWaive},
+			freeAgent,
+			validation.Errors{{Field: "player_id", Code: CodeFreeAgent}},
+		},
+		{
+			models.Transaction{Type: models.TransactionActivate},
+			rostered,
+			validation.Errors{{Field: "status", Code: validation.CodeInvalid}},
+		},
+	}
+
+	for _, c := range cases {
+		transaction := c.transaction
+
+		assert.Equal(t, c.expected, plan(&transaction, c.player))
+	}
+}
+
+func TestPlanTrade(t *testing.T) {
+	trade := models.NewTradeModel(&dto.TradeDTO{Items: []dto.TradeItemDTO{
+		{PlayerID: 1, FromTeamID: 1, ToTeamID: 2},
+		{PlayerID: 2, FromTeamID: 2, ToTeamID: 1},
+		{PlayerID: 3, FromTeamID: 1, ToTeamID: 2},
+	}, Picks: []dto.TradePickDTO{
+		{PickID: 1, FromTeamID: 1, ToTeamID: 2},
+		{PickID: 2, FromTeamID: 2, ToTeamID: 1},
+		{PickID: 3, FromTeamID: 1, ToTeamID: 2},
+	}})
+
+	picks := []models.DraftPick{
+		{ID: 1, OwnerTeamID: 1},
+		{ID: 2, OwnerTeamID: 2, PlayerID: intPtr(5)},
+		{ID: 3, OriginalTeamID: 1, OwnerTeamID: 3},
+	}
+
+	players := []models.Player{
+		{ID: 1, TeamID: intPtr(1), Status: models.PlayerInjuredReserve},
+		{ID: 2, TeamID: intPtr(3), Status: models.PlayerActive},
+	}
+
+	assert.Equal(t, validation.Errors{
+		{Field: "items[1].from_team_id", Code: validation.CodeNotOnTeam},
+		{Field: "items[2].player_id", Code: validation.CodeNotFound},
+		{Field: "picks[1].pick_id", Code: CodePickUsed},
+		{Field: "picks[2].from_team_id", Code: CodeNotOwned},
+	}, planTrade(&trade, players, picks))
+
+	assert.Equal(t, models.PlayerInjuredReserve, trade.Transactions[0].Status)
+	assert.Equal(t, models.TransactionTrade, trade.Transactions[0].Type)
+}
@@ -0,0 +1,177 @@
+package transaction
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/logansua/nfl_app/db"
+	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
+	"github.com/logansua/nfl_app/router"
+	"github.com/logansua/nfl_app/validation"
+	"net/http"
+	"strconv"
+)
+
+func GetServiceOptions(logger log.Logger) []httptransport.ServerOption {
+	return router.ServerOptions(logger)
+}
+
+func CreateRoutes(s Service, logger log.Logger, authenticate endpoint.Middleware) []router.Route {
+	endpoints := MakeServerEndpoints(s, authenticate)
+
+	options := GetServiceOptions(logger)
+
+	return []router.Route{
+		{
+			Name:        "Create transaction",
+			Method:      http.MethodPost,
+			Path:        "/transactions",
+			StrictSlash: false,
+			Handler: httptransport.NewServer(
+				endpoints.CreateTransactionEndpoint,
+				decodeCreateTransactionRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get player history",
+			Method:      http.MethodGet,
+			Path:        "/players/{id}/history",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetPlayerHistoryEndpoint,
+				decodeGetPlayerHistoryRequest,
+				router.EncodePaginatedResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get team transactions",
+			Method:      http.MethodGet,
+			Path:        "/teams/{id}/transactions",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetTeamTransactionsEndpoint,
+				decodeGetTeamTransactionsRequest,
+				router.EncodePaginatedResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Create trade",
+			Method:      http.MethodPost,
+			Path:        "/trades",
+			StrictSlash: false,
+			Handler: httptransport.NewServer(
+				endpoints.CreateTradeEndpoint,
+				decodeCreateTradeRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+		{
+			Name:        "Get trade",
+			Method:      http.MethodGet,
+			Path:        "/trades/{id}",
+			StrictSlash: true,
+			Handler: httptransport.NewServer(
+				endpoints.GetTradeEndpoint,
+				decodeGetTradeRequest,
+				router.EncodeResponse,
+				options...,
+			),
+		},
+	}
+}
+
+func decodeCreateTransactionRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req createTransactionRequest
+
+	if e := validation.DecodeJSON(r.Body, &req.Transaction); e != nil {
+		return nil, e
+	}
+
+	return req, nil
+}
+func decodeGetPlayerHistoryRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req getPlayerHistoryRequest
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	paging, q, err := decodeListParams(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.playerID = id
+	req.Paging = paging
+	req.Query = q
+
+	return req, nil
+}
+func decodeGetTeamTransactionsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req getTeamTransactionsRequest
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	paging, q, err := decodeListParams(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.teamID = id
+	req.Paging = paging
+	req.Query = q
+
+	return req, nil
+}
+func decodeCreateTradeRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req createTradeRequest
+
+	if e := validation.DecodeJSON(r.Body, &req.Trade); e != nil {
+		return nil, e
+	}
+
+	return req, nil
+}
+func decodeGetTradeRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req getTradeRequest
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.id = id
+
+	return req, nil
+}
+
+func decodeListParams(r *http.Request) (pagination.Pagination, query.Query, error) {
+	params := r.URL.Query()
+
+	paging, err := pagination.Parse(params)
+
+	if err != nil {
+		return pagination.Pagination{}, query.Query{}, err
+	}
+
+	q, err := query.New(params, db.TransactionQueryFields)
+
+	return paging, q, err
+}
@@ -0,0 +1,55 @@
+package transaction
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/logansua/nfl_app/auth"
+	"github.com/logansua/nfl_app/db"
+	"github.com/logansua/nfl_app/mocks"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/router"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// Handler of transaction routes called by the given principal
+func newTestHandler(dbService *db.DB, p auth.Principal) http.Handler {
+	authenticate := func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			return next(auth.NewContext(ctx, p), request)
+		}
+	}
+
+	return router.New(CreateRoutes(New(dbService, nil), log.NewNopLogger(), authenticate))
+}
+
+func TestCreateTransaction_Forbidden(t *testing.T) {
+	repository := &mocks.Repository{}
+	repository.On("FindById", mock.AnythingOfType("*models.Player"), 1).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*models.Player) = models.Player{ID: 1, Name: "TEST_PLAYER", TeamID: intPtr(2)}
+		}).
+		Return(nil)
+
+	principals := []auth.Principal{
+		{Role: models.RoleViewer},
+		{Role: models.RoleTeamManager, TeamIDs: []int{1}},
+	}
+
+	for _, p := range principals {
+		w := httptest.NewRecorder()
+		body := strings.NewReader(`{"type":"trade","player_id":1,"to_team_id":1}`)
+
+		newTestHandler(&db.DB{Repository: repository}, p).
+			ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/transactions", body))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	}
+
+	repository.AssertNumberOfCalls(t, "FindById", 1)
+}
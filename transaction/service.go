@@ -0,0 +1,164 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"github.com/logansua/nfl_app/db"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/pagination"
+	"github.com/logansua/nfl_app/query"
+	"github.com/logansua/nfl_app/team"
+	"github.com/logansua/nfl_app/validation"
+)
+
+// Service moves players between teams and keeps the history of roster moves.
+type Service interface {
+	// Execute roster move and record it
+	CreateTransaction(ctx context.Context, transaction *dto.TransactionDTO) error
+	// Get transactions of the player
+	GetPlayerHistory(ctx context.Context, playerID int, paging pagination.Pagination, q query.Query, transactions *[]dto.TransactionDTO, meta *pagination.Meta) error
+	// Get transactions the team took part in
+	GetTeamTransactions(ctx context.Context, teamID int, paging pagination.Pagination, q query.Query, transactions *[]dto.TransactionDTO, meta *pagination.Meta) error
+	// Execute all moves of the trade at once, nothing is applied when any player can't be moved
+	CreateTrade(ctx context.Context, trade *dto.TradeDTO) error
+	// Get trade with moves of all players
+	GetTrade(ctx context.Context, id int, trade *dto.TradeDTO) error
+	// Get player with the current team, used to authorize roster moves
+	GetPlayer(ctx context.Context, id int, player *dto.PlayerDTO) error
+}
+
+type service struct {
+	DB          *db.DB
+	TeamService team.Service
+}
+
+func New(dbService *db.DB, teamService team.Service) Service {
+	return &service{
+		DB:          dbService,
+		TeamService: teamService,
+	}
+}
+
+func (s *service) CreateTransaction(ctx context.Context, transaction *dto.TransactionDTO) error {
+	t := models.NewTransactionModel(transaction)
+
+	var p models.Player
+
+	err := s.DB.Repository.FindById(&p, t.PlayerID)
+
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return validation.Errors{{Field: "player_id", Code: validation.CodeNotFound}}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	err = plan(&t, p)
+
+	if err != nil {
+		return err
+	}
+
+	err = s.checkTeam(ctx, t.ToTeamID)
+
+	if err != nil {
+		return err
+	}
+
+	err = s.DB.TransactionRepository.CreateTransaction(&t)
+
+	if err != nil {
+		return err
+	}
+
+	*transaction = models.NewTransactionDTO(t)
+
+	return nil
+}
+
+func (s *service) GetPlayer(ctx context.Context, id int, player *dto.PlayerDTO) error {
+	var p models.Player
+
+	err := s.DB.Repository.FindById(&p, id)
+
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return validation.Errors{{Field: "player_id", Code: validation.CodeNotFound}}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	*player = models.NewPlayerDTO(p)
+
+	return nil
+}
+
+// Referential check of the team player joins
+func (s *service) checkTeam(ctx context.Context, teamID *int) error {
+	if teamID == nil {
+		return nil
+	}
+
+	var teamDTO dto.TeamDTO
+
+	err := s.TeamService.GetTeam(ctx, *teamID, &teamDTO)
+
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return validation.Errors{{Field: "to_team_id", Code: validation.CodeNotFound}}
+	}
+
+	return err
+}
+
+func (s *service) GetPlayerHistory(ctx context.Context, playerID int, paging pagination.Pagination, q query.Query, transactions *[]dto.TransactionDTO, meta *pagination.Meta) error {
+	var p models.Player
+
+	err := s.DB.Repository.FindById(&p, playerID)
+
+	if err != nil {
+		return err
+	}
+
+	q.Filters = append(q.Filters, query.Filter{Column: "player_id", Operator: query.Equal, Value: playerID})
+
+	return findTransactions(q, transactions, func(q query.Query, out *[]models.Transaction) error {
+		return s.DB.TransactionRepository.FindAllAndPaginate(paging, q, out, meta)
+	})
+}
+
+func (s *service) GetTeamTransactions(ctx context.Context, teamID int, paging pagination.Pagination, q query.Query, transactions *[]dto.TransactionDTO, meta *pagination.Meta) error {
+	var teamDTO dto.TeamDTO
+
+	err := s.TeamService.GetTeam(ctx, teamID, &teamDTO)
+
+	if err != nil {
+		return err
+	}
+
+	return findTransactions(q, transactions, func(q query.Query, out *[]models.Transaction) error {
+		return s.DB.TransactionRepository.FindTeamTransactions(teamID, paging, q, out, meta)
+	})
+}
+
+// Transactions are listed in chronological order unless other sorting is requested
+func findTransactions(q query.Query, transactions *[]dto.TransactionDTO, find func(q query.Query, out *[]models.Transaction) error) error {
+	if len(q.Sort) == 0 {
+		q.Sort = []query.Sort{{Column: "created_at"}}
+	}
+
+	var t []models.Transaction
+
+	err := find(q, &t)
+
+	*transactions = make([]dto.TransactionDTO, len(t))
+
+	for key, value := range t {
+		(*transactions)[key] = models.NewTransactionDTO(value)
+	}
+
+	return err
+}
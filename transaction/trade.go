@@ -0,0 +1,134 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+)
+
+func (s *service) CreateTrade(ctx context.Context, trade *dto.TradeDTO) error {
+	t := models.NewTradeModel(trade)
+
+	err := s.checkTradeTeams(t)
+
+	if err != nil {
+		return err
+	}
+
+	err = s.DB.TransactionRepository.CreateTrade(&t, func(players []models.Player, picks []models.DraftPick) error {
+		return planTrade(&t, players, picks)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	*trade = models.NewTradeDTO(t)
+
+	return nil
+}
+
+func (s *service) GetTrade(ctx context.Context, id int, trade *dto.TradeDTO) error {
+	var t models.Trade
+
+	err := s.DB.TransactionRepository.FindTrade(id, &t)
+
+	if err != nil {
+		return err
+	}
+
+	*trade = models.NewTradeDTO(t)
+
+	return nil
+}
+
+// Referential check of the teams receiving players and picks, each missing team is reported on its items
+func (s *service) checkTradeTeams(t models.Trade) error {
+	var ids []int
+
+	for _, value := range t.Transactions {
+		ids = append(ids, *value.ToTeamID)
+	}
+
+	for _, value := range t.PickTransfers {
+		ids = append(ids, value.ToTeamID)
+	}
+
+	var teams []models.Team
+
+	err := s.DB.Repository.FindByIds(&teams, ids)
+
+	if err != nil {
+		return err
+	}
+
+	found := make(map[int]bool, len(teams))
+
+	for _, value := range teams {
+		found[int(value.ID)] = true
+	}
+
+	errs := validation.New()
+
+	for key, value := range t.Transactions {
+		errs.Check(found[*value.ToTeamID], fmt.Sprintf("items[%d].to_team_id", key), validation.CodeNotFound)
+	}
+
+	for key, value := range t.PickTransfers {
+		errs.Check(found[value.ToTeamID], fmt.Sprintf("picks[%d].to_team_id", key), validation.CodeNotFound)
+	}
+
+	return errs.Err()
+}
+
+// Check the locked players and picks against the proposal, traded players keep their roster status
+func planTrade(t *models.Trade, players []models.Player, picks []models.DraftPick) error {
+	byID := make(map[int]models.Player, len(players))
+
+	for _, value := range players {
+		byID[int(value.ID)] = value
+	}
+
+	errs := validation.New()
+
+	for key := range t.Transactions {
+		item := &t.Transactions[key]
+		field := fmt.Sprintf("items[%d].", key)
+		player, ok := byID[item.PlayerID]
+
+		if !ok {
+			errs.Add(field+"player_id", validation.CodeNotFound)
+
+			continue
+		}
+
+		errs.Check(player.TeamID != nil && *player.TeamID == *item.FromTeamID, field+"from_team_id", validation.CodeNotOnTeam)
+
+		item.Status = player.Status
+	}
+
+	picksByID := make(map[int]models.DraftPick, len(picks))
+
+	for _, value := range picks {
+		picksByID[int(value.ID)] = value
+	}
+
+	for key, transfer := range t.PickTransfers {
+		field := fmt.Sprintf("picks[%d].", key)
+		pick, ok := picksByID[transfer.DraftPickID]
+
+		if !ok {
+			errs.Add(field+"pick_id", validation.CodeNotFound)
+
+			continue
+		}
+
+		errs.
+			Check(pick.PlayerID == nil, field+"pick_id", CodePickUsed).
+			Check(pick.OwnerTeamID == transfer.FromTeamID, field+"from_team_id", CodeNotOwned)
+	}
+
+	return errs.Err()
+}
@@ -0,0 +1,88 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"github.com/logansua/nfl_app/validation"
+)
+
+const (
+	maxLength     = 255
+	maxTradeItems = 50
+)
+
+func validateTransaction(t dto.TransactionDTO) error {
+	errs := validation.New().
+		OneOf("type", t.Type, models.TransactionTypes...).
+		ID("player_id", t.PlayerID).
+		MaxLength("note", t.Note, maxLength)
+
+	switch t.Type {
+	case models.TransactionSign, models.TransactionTrade:
+		if t.ToTeamID == nil {
+			errs.Add("to_team_id", validation.CodeRequired)
+		} else {
+			errs.ID("to_team_id", *t.ToTeamID)
+		}
+	default:
+		// Released and waived players stay without team, other moves keep the current one
+		errs.Check(t.ToTeamID == nil, "to_team_id", validation.CodeInvalid)
+	}
+
+	// Only signed players can start on the practice squad, other transactions set the status
+	if t.Type != models.TransactionSign {
+		errs.Check(t.Status == "", "status", validation.CodeInvalid)
+	}
+
+	return errs.Err()
+}
+
+func (r createTransactionRequest) Validate(_ context.Context) error {
+	return validateTransaction(r.Transaction)
+}
+
+func validateTrade(t dto.TradeDTO) error {
+	errs := validation.New().
+		Check(len(t.Items)+len(t.Picks) > 0, "items", validation.CodeRequired).
+		Check(len(t.Items) <= maxTradeItems, "items", validation.CodeMax).
+		Check(len(t.Picks) <= maxTradeItems, "picks", validation.CodeMax).
+		MaxLength("note", t.Note, maxLength)
+
+	players := make(map[int]bool, len(t.Items))
+
+	for key, item := range t.Items {
+		field := fmt.Sprintf("items[%d].", key)
+
+		errs.
+			ID(field+"player_id", item.PlayerID).
+			ID(field+"from_team_id", item.FromTeamID).
+			ID(field+"to_team_id", item.ToTeamID).
+			Check(item.FromTeamID != item.ToTeamID, field+"to_team_id", CodeSameTeam).
+			Check(!players[item.PlayerID], field+"player_id", validation.CodeDuplicate)
+
+		players[item.PlayerID] = true
+	}
+
+	picks := make(map[int]bool, len(t.Picks))
+
+	for key, pick := range t.Picks {
+		field := fmt.Sprintf("picks[%d].", key)
+
+		errs.
+			ID(field+"pick_id", pick.PickID).
+			ID(field+"from_team_id", pick.FromTeamID).
+			ID(field+"to_team_id", pick.ToTeamID).
+			Check(pick.FromTeamID != pick.ToTeamID, field+"to_team_id", CodeSameTeam).
+			Check(!picks[pick.PickID], field+"pick_id", validation.CodeDuplicate)
+
+		picks[pick.PickID] = true
+	}
+
+	return errs.Err()
+}
+
+func (r createTradeRequest) Validate(_ context.Context) error {
+	return validateTrade(r.Trade)
+}
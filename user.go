@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"github.com/go-kit/kit/log"
+	"github.com/logansua/nfl_app/auth"
+	"github.com/logansua/nfl_app/db"
+	"github.com/logansua/nfl_app/models"
+	"github.com/logansua/nfl_app/models/dto"
+	"strconv"
+)
+
+const userUsage = "usage: user create EMAIL PASSWORD [ROLE [TEAM_ID...]]|role EMAIL ROLE [TEAM_ID...]"
+
+// Run user subcommand, there is no public sign up so users and their roles are managed here
+func runUser(args []string, logger log.Logger) error {
+	if len(args) < 3 {
+		return errors.New(userUsage)
+	}
+
+	var (
+		credentials dto.CredentialsDTO
+		role        = models.RoleViewer
+		rest        []string
+	)
+
+	switch args[0] {
+	case "create":
+		credentials = dto.CredentialsDTO{Email: args[1], Password: args[2]}
+
+		if len(args) > 3 {
+			role = args[3]
+			rest = args[4:]
+		}
+
+		if err := auth.ValidateUser(credentials); err != nil {
+			return err
+		}
+	case "role":
+		credentials.Email = args[1]
+		role = args[2]
+		rest = args[3:]
+	default:
+		return errors.New(userUsage)
+	}
+
+	teamIDs := make([]int, len(rest))
+
+	for i, arg := range rest {
+		id, err := strconv.Atoi(arg)
+
+		if err != nil {
+			return errors.New(userUsage)
+		}
+
+		teamIDs[i] = id
+	}
+
+	if err := auth.ValidateRole(role, teamIDs); err != nil {
+		return err
+	}
+
+	dbService, err := db.New()
+
+	if err != nil {
+		return err
+	}
+
+	defer dbService.DB.Close()
+
+	authService, err := auth.New(dbService)
+
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	if args[0] == "create" {
+		err = authService.CreateUser(ctx, credentials, role, teamIDs)
+	} else {
+		err = authService.UpdateUserRole(ctx, credentials.Email, role, teamIDs)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	logger.Log("user", args[0], "email", credentials.Email, "role", role)
+
+	return nil
+}
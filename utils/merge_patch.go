@@ -0,0 +1,62 @@
+package utils
+
+import "encoding/json"
+
+// Apply JSON merge patch (RFC 7386) to the original document
+func MergePatch(original, patch []byte) ([]byte, error) {
+	var doc, p interface{}
+
+	if err := json.Unmarshal(original, &doc); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(mergeValue(doc, p))
+}
+
+// Decode top-level member of the merge patch into out, false is returned when the patch doesn't set it.
+// Null members are decoded as zero values, malformed patches are left to MergePatch to report.
+func PatchedField(patch []byte, name string, out interface{}) (bool, error) {
+	var members map[string]json.RawMessage
+
+	if json.Unmarshal(patch, &members) != nil {
+		return false, nil
+	}
+
+	value, ok := members[name]
+
+	if !ok {
+		return false, nil
+	}
+
+	return true, json.Unmarshal(value, out)
+}
+
+func mergeValue(target, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+
+	if !ok {
+		targetObject = map[string]interface{}{}
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+
+			continue
+		}
+
+		targetObject[key] = mergeValue(targetObject[key], value)
+	}
+
+	return targetObject
+}
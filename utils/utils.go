@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"github.com/logansua/nfl_app/pagination"
 	"github.com/satori/go.uuid"
 	"reflect"
 )
@@ -10,6 +11,12 @@ type DataResponse struct {
 	Err  error       `json:"error,omitempty"`
 }
 
+type PaginatedResponse struct {
+	Data interface{}     `json:"data"`
+	Meta pagination.Meta `json:"meta"`
+	Err  error           `json:"error,omitempty"`
+}
+
 func RandToken() string {
 	return uuid.NewV4().String()
 }
@@ -0,0 +1,40 @@
+package validation
+
+import (
+	"encoding/json"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"io"
+	"strings"
+)
+
+// Decode JSON body rejecting unknown fields, malformed fields are reported as field errors
+func DecodeJSON(r io.Reader, v interface{}) error {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(v)
+
+	if err == nil {
+		return nil
+	}
+
+	if err == io.EOF {
+		return apperrors.Wrap(apperrors.KindInvalidArgument, "request body is empty", err)
+	}
+
+	if err == io.ErrUnexpectedEOF {
+		return apperrors.Wrap(apperrors.KindInvalidArgument, "request body is truncated", err)
+	}
+
+	if typeErr, ok := err.(*json.UnmarshalTypeError); ok {
+		return Errors{{Field: typeErr.Field, Code: CodeInvalid}}
+	}
+
+	const unknownFieldPrefix = "json: unknown field "
+
+	if message := err.Error(); strings.HasPrefix(message, unknownFieldPrefix) {
+		return Errors{{Field: strings.Trim(strings.TrimPrefix(message, unknownFieldPrefix), `"`), Code: CodeUnknown}}
+	}
+
+	return err
+}
@@ -0,0 +1,145 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kit/kit/endpoint"
+	"strings"
+	"unicode/utf8"
+)
+
+// Error codes of invalid fields
+const (
+	CodeRequired = "required"
+	CodeTooLong  = "too_long"
+	CodeMin      = "min"
+	CodeMax      = "max"
+	CodeInvalid  = "invalid"
+	CodeUnknown  = "unknown"
+	CodeNotFound = "not_found"
+	CodeTaken    = "taken"
+	// Same value is listed more than once
+	CodeDuplicate = "duplicate"
+	// Player isn't on the roster of the team
+	CodeNotOnTeam = "not_on_team"
+)
+
+type FieldError struct {
+	Field string `json:"field"`
+	Code  string `json:"code"`
+}
+
+// Errors is a list of invalid fields, it is returned to the client as 422 Unprocessable Entity
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	fields := make([]string, len(e))
+
+	for key, value := range e {
+		fields[key] = fmt.Sprintf("%s: %s", value.Field, value.Code)
+	}
+
+	return "validation failed: " + strings.Join(fields, ", ")
+}
+
+// Validator is implemented by requests which should be checked before reaching the service
+type Validator interface {
+	Validate(ctx context.Context) error
+}
+
+// Middleware rejects requests implementing Validator which are not valid
+func Middleware() endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			if v, ok := request.(Validator); ok {
+				if err := v.Validate(ctx); err != nil {
+					return nil, err
+				}
+			}
+
+			return next(ctx, request)
+		}
+	}
+}
+
+// Builder collects field errors
+type Builder struct {
+	errors Errors
+}
+
+func New() *Builder {
+	return &Builder{}
+}
+
+func (b *Builder) Add(field, code string) *Builder {
+	b.errors = append(b.errors, FieldError{Field: field, Code: code})
+
+	return b
+}
+
+func (b *Builder) Required(field, value string) *Builder {
+	if strings.TrimSpace(value) == "" {
+		b.Add(field, CodeRequired)
+	}
+
+	return b
+}
+
+func (b *Builder) MaxLength(field, value string, max int) *Builder {
+	if utf8.RuneCountInString(value) > max {
+		b.Add(field, CodeTooLong)
+	}
+
+	return b
+}
+
+// Reference to another entity, zero value is treated as missing
+func (b *Builder) ID(field string, value int) *Builder {
+	switch {
+	case value == 0:
+		b.Add(field, CodeRequired)
+	case value < 0:
+		b.Add(field, CodeMin)
+	}
+
+	return b
+}
+
+func (b *Builder) Range(field string, value, min, max int) *Builder {
+	switch {
+	case value < min:
+		b.Add(field, CodeMin)
+	case value > max:
+		b.Add(field, CodeMax)
+	}
+
+	return b
+}
+
+func (b *Builder) OneOf(field, value string, allowed ...string) *Builder {
+	for _, a := range allowed {
+		if value == a {
+			return b
+		}
+	}
+
+	return b.Add(field, CodeInvalid)
+}
+
+// Add error with given code when condition is not met
+func (b *Builder) Check(ok bool, field, code string) *Builder {
+	if !ok {
+		b.Add(field, code)
+	}
+
+	return b
+}
+
+// Collected errors, nil when all fields are valid
+func (b *Builder) Err() error {
+	if len(b.errors) == 0 {
+		return nil
+	}
+
+	return b.errors
+}
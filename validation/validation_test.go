@@ -0,0 +1,65 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	apperrors "github.com/logansua/nfl_app/errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+type testRequest struct {
+	Name   string `json:"name"`
+	TeamID int    `json:"team_id"`
+}
+
+func (r testRequest) Validate(_ context.Context) error {
+	return New().
+		Required("name", r.Name).
+		MaxLength("name", r.Name, 5).
+		ID("team_id", r.TeamID).
+		Err()
+}
+
+func TestMiddleware(t *testing.T) {
+	called := false
+	endpoint := Middleware()(func(ctx context.Context, request interface{}) (interface{}, error) {
+		called = true
+
+		return nil, nil
+	})
+
+	_, err := endpoint(context.Background(), testRequest{Name: "", TeamID: -1})
+
+	assert.False(t, called)
+	assert.Equal(t, Errors{
+		{Field: "name", Code: CodeRequired},
+		{Field: "team_id", Code: CodeMin},
+	}, err)
+
+	_, err = endpoint(context.Background(), testRequest{Name: "Brady", TeamID: 1})
+
+	assert.True(t, called)
+	assert.Nil(t, err)
+}
+
+func TestDecodeJSON(t *testing.T) {
+	var req testRequest
+
+	err := DecodeJSON(strings.NewReader(`{"name":"Brady","password":"secret"}`), &req)
+
+	assert.Equal(t, Errors{{Field: "password", Code: CodeUnknown}}, err)
+
+	err = DecodeJSON(strings.NewReader(`{"name":"Brady","team_id":"three"}`), &req)
+
+	assert.Equal(t, Errors{{Field: "team_id", Code: CodeInvalid}}, err)
+
+	err = DecodeJSON(strings.NewReader(""), &req)
+
+	assert.True(t, errors.Is(err, apperrors.ErrInvalidArgument))
+
+	err = DecodeJSON(strings.NewReader(`{"name":"Br`), &req)
+
+	assert.True(t, errors.Is(err, apperrors.ErrInvalidArgument))
+}